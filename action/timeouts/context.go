@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+// InvokeContext returns a copy of ctx which is cancelled after the timeout returned by
+// Invoke, along with its cancel function, which should be deferred by the caller.
+// The cause of the cancellation, returned by context.Cause, is a *TimeoutError
+// for the "invoke" timeout. If any diagnostics are generated they are returned along
+// with a context derived from the supplied default timeout.
+func (t Value) InvokeContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	r, diags := t.resolveTimeout(ctx, attributeNameInvoke, defaultTimeout, path.Root("timeouts"))
+
+	ctx, cancel := timeoutctx.WithTimeout(ctx, attributeNameInvoke, r.Timeout, r.Source)
+
+	return ctx, cancel, diags
+}
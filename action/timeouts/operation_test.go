@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+)
+
+func TestForRequest(t *testing.T) {
+	t.Parallel()
+
+	if got := timeouts.OperationForRequest(action.InvokeRequest{}); got != timeouts.OperationInvoke {
+		t.Errorf("expected %q, got %q", timeouts.OperationInvoke, got)
+	}
+
+	value := timeouts.NewValueMust(timeouts.Opts{}, map[string]time.Duration{
+		"invoke": 30 * time.Minute,
+	})
+
+	got, diags := timeouts.ForRequest(context.Background(), value, action.InvokeRequest{}, 20*time.Minute)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if got != 30*time.Minute {
+		t.Errorf("expected invoke timeout %s, got %s", 30*time.Minute, got)
+	}
+}
@@ -5,22 +5,33 @@ package timeouts
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutsvalue"
 )
 
 var (
 	_ basetypes.ObjectTypable  = Type{}
 	_ basetypes.ObjectValuable = Value{}
+	_ Timeouts                 = Value{}
 )
 
+// core implements Type and Value for the "invoke" operation.
+var core = timeoutsvalue.Core[Type, Value]{
+	Operations: []string{attributeNameInvoke},
+}
+
+// Timeouts is implemented by the Value of every timeouts package, so that helpers
+// can accept the Value of any package.
+type Timeouts = timeoutsvalue.Timeouts
+
 // Type is an attribute type that represents timeouts.
 type Type struct {
 	basetypes.ObjectType
@@ -33,30 +44,14 @@ func (t Type) String() string {
 
 // ValueFromObject returns a Value given a basetypes.ObjectValue.
 func (t Type) ValueFromObject(_ context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
-	value := Value{
-		Object: in,
-	}
-
-	return value, nil
+	return core.ValueFromObject(in), nil
 }
 
 // ValueFromTerraform returns a Value given a tftypes.Value.
 // Value embeds the types.Object value returned from calling ValueFromTerraform on the
 // types.ObjectType embedded in Type.
 func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	val, err := t.ObjectType.ValueFromTerraform(ctx, in)
-	if err != nil {
-		return nil, err
-	}
-
-	obj, ok := val.(types.Object)
-	if !ok {
-		return nil, fmt.Errorf("%T cannot be used as types.Object", val)
-	}
-
-	return Value{
-		obj,
-	}, err
+	return core.ValueFromTerraform(ctx, t, in)
 }
 
 // ValueType returns the associated Value type for debugging.
@@ -68,12 +63,7 @@ func (t Type) ValueType(context.Context) attr.Value {
 // Equal returns true if `candidate` is also a Type and has the same
 // AttributeTypes.
 func (t Type) Equal(candidate attr.Type) bool {
-	other, ok := candidate.(Type)
-	if !ok {
-		return false
-	}
-
-	return t.ObjectType.Equal(other.ObjectType)
+	return core.TypeEqual(t, candidate)
 }
 
 // Value represents an object containing values to be used as time.Duration for timeouts.
@@ -84,13 +74,7 @@ type Value struct {
 // Equal returns true if the Value is considered semantically equal
 // (same type and same value) to the attr.Value passed as an argument.
 func (t Value) Equal(c attr.Value) bool {
-	other, ok := c.(Value)
-
-	if !ok {
-		return false
-	}
-
-	return t.Object.Equal(other.Object)
+	return core.ValueEqual(t, c)
 }
 
 // ToObjectValue returns the underlying ObjectValue.
@@ -100,11 +84,7 @@ func (v Value) ToObjectValue(_ context.Context) (basetypes.ObjectValue, diag.Dia
 
 // Type returns a Type with the same attribute types as `t`.
 func (t Value) Type(ctx context.Context) attr.Type {
-	return Type{
-		types.ObjectType{
-			AttrTypes: t.AttributeTypes(ctx),
-		},
-	}
+	return core.ValueType(ctx, t)
 }
 
 // Invoke attempts to retrieve the "invoke" attribute and parse it as time.Duration.
@@ -113,34 +93,40 @@ func (t Value) Invoke(ctx context.Context, defaultTimeout time.Duration) (time.D
 	return t.getTimeout(ctx, attributeNameInvoke, defaultTimeout)
 }
 
-func (t Value) getTimeout(ctx context.Context, timeoutName string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	value, ok := t.Object.Attributes()[timeoutName]
-	if !ok {
-		tflog.Info(ctx, timeoutName+" timeout configuration not found, using provided default")
-
-		return defaultTimeout, diags
-	}
+// Operations returns the names of the operations whose timeouts can be configured,
+// which is only "invoke".
+func (t Value) Operations() []string {
+	return append([]string(nil), core.Operations...)
+}
 
-	if value.IsNull() || value.IsUnknown() {
-		tflog.Info(ctx, timeoutName+" timeout configuration is null or unknown, using provided default")
+// OperationTimeout returns the timeout for the named operation, as returned by the
+// accessor for the operation, such as Invoke for "invoke". The supplied default timeout is
+// returned for an operation which is not supported. If any diagnostics are generated
+// they are returned along with the supplied default timeout.
+func (t Value) OperationTimeout(ctx context.Context, operation string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.getTimeout(ctx, operation, defaultTimeout)
+}
 
-		return defaultTimeout, diags
-	}
+// ResolveOperation returns the Resolution of the timeout returned by
+// OperationTimeout, for timeouts at the root of the schema. If any diagnostics are
+// generated they are returned along with a Resolution of the supplied default
+// timeout.
+func (t Value) ResolveOperation(ctx context.Context, operation string, defaultTimeout time.Duration) (Resolution, diag.Diagnostics) {
+	return t.ResolveOperationAtPath(ctx, operation, defaultTimeout, path.Root("timeouts"))
+}
 
-	// No type assertion check is required as the schema guarantees that the object attributes
-	// are types.String.
-	//nolint:forcetypeassert
-	timeout, err := time.ParseDuration(value.(types.String).ValueString())
-	if err != nil {
-		diags.Append(diag.NewErrorDiagnostic(
-			"Timeout Cannot Be Parsed",
-			fmt.Sprintf("timeout for %q cannot be parsed, %s", timeoutName, err),
-		))
+// ResolveOperationAtPath is the same as ResolveOperation, but the Path of the
+// Resolution is within the timeouts attribute or block at timeoutsPath.
+func (t Value) ResolveOperationAtPath(ctx context.Context, operation string, defaultTimeout time.Duration, timeoutsPath path.Path) (Resolution, diag.Diagnostics) {
+	return t.resolveTimeout(ctx, operation, defaultTimeout, timeoutsPath)
+}
 
-		return defaultTimeout, diags
-	}
+func (t Value) getTimeout(ctx context.Context, timeoutName string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return core.Timeout(ctx, t, timeoutName, defaultTimeout)
+}
 
-	return timeout, diags
+// resolveTimeout returns the timeout for the named operation along with where it
+// was resolved from, with the Path of the Resolution within timeoutsPath.
+func (t Value) resolveTimeout(ctx context.Context, timeoutName string, defaultTimeout time.Duration, timeoutsPath path.Path) (Resolution, diag.Diagnostics) {
+	return core.Resolve(ctx, t, timeoutName, defaultTimeout, timeoutsPath)
 }
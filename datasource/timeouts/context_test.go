@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+)
+
+// The cause of the context is tested with the internal timeoutctx package.
+func TestTimeoutsValueReadContext(t *testing.T) {
+	t.Parallel()
+
+	timeoutsValue := timeouts.NewValueMust(timeouts.Opts{}, map[string]time.Duration{
+		"read": 30 * time.Minute,
+	})
+
+	start := time.Now()
+
+	ctx, cancel, diags := timeoutsValue.ReadContext(context.Background(), 20*time.Minute)
+	defer cancel()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		t.Fatal("expected a deadline")
+	}
+
+	if got := deadline.Sub(start); got < 30*time.Minute || got > 31*time.Minute {
+		t.Errorf("expected a deadline 30m from the start, got %s", got)
+	}
+}
+
+func TestTimeoutsValueReadContextTimeoutError(t *testing.T) {
+	t.Parallel()
+
+	timeoutsValue := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"read": types.StringType,
+			},
+			map[string]attr.Value{
+				"read": types.StringNull(),
+			},
+		),
+	}
+
+	ctx, cancel, diags := timeoutsValue.ReadContext(context.Background(), time.Nanosecond)
+	defer cancel()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	<-ctx.Done()
+
+	cause := context.Cause(ctx)
+
+	if !errors.Is(cause, context.DeadlineExceeded) {
+		t.Errorf("expected cause to wrap context.DeadlineExceeded, got %v", cause)
+	}
+
+	var timeoutErr *timeouts.TimeoutError
+
+	if !errors.As(cause, &timeoutErr) {
+		t.Fatalf("expected cause to be *timeouts.TimeoutError, got %T", cause)
+	}
+
+	if timeoutErr.Operation != "read" {
+		t.Errorf("expected operation %q, got %q", "read", timeoutErr.Operation)
+	}
+
+	if timeoutErr.Timeout != time.Nanosecond {
+		t.Errorf("expected timeout %s, got %s", time.Nanosecond, timeoutErr.Timeout)
+	}
+
+	if timeoutErr.Source != timeouts.SourceDefault {
+		t.Errorf("expected source %q, got %q", timeouts.SourceDefault, timeoutErr.Source)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Convert returns a Value whose timeouts are taken from the Value of another
+// timeouts package, such as that of a resource, so that code expecting a Value can be
+// shared. The operations map is keyed by each Operation of the returned Value, with
+// the name of the operation of from whose timeout it takes as the value, such as
+// {OperationRead: "read"} to take the Read timeout from a resource.
+//
+// The configured values, such as "2x", are copied rather than resolved, so the
+// accessors of the returned Value resolve them against the supplied default
+// timeout, and return an error diagnostic for an invalid value as the accessors of
+// from do. An operation of from which is not configured takes the value of the
+// "default" attribute of an SDKv2 block where it applies, as it does for from.
+// The Resolution of a converted timeout has no Path, as the returned Value is not
+// configured. A null or unknown from returns a null or unknown Value. If any
+// diagnostics are generated, such as for an operation which is not supported or
+// a from with a "deadline", they are returned along with a zero Value.
+func Convert(ctx context.Context, from Timeouts, operations map[Operation]string) (Value, diag.Diagnostics) {
+	names := make(map[string]string, len(operations))
+
+	for to, name := range operations {
+		names[string(to)] = name
+	}
+
+	return core.Convert(ctx, from, names)
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	resourcetimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	from := resourcetimeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+				"read":   types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringValue("1h"),
+				"read":   types.StringValue("150%"),
+			},
+		),
+	}
+
+	value, diags := timeouts.Convert(context.Background(), from, map[timeouts.Operation]string{
+		timeouts.OperationRead: "read",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	got, diags := value.Read(context.Background(), 20*time.Minute)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, 30*time.Minute); diff != "" {
+		t.Errorf("unexpected timeout difference: %s", diff)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutdiag"
+)
+
+// DeadlineExceededDiagnostic returns an error diagnostic describing a timeout of
+// a context returned by the Value context accessors, or nil if err is not caused
+// by such a timeout. err is typically the error returned by a client call using
+// ctx, and may either wrap a *TimeoutError or context.DeadlineExceeded, in which
+// case the *TimeoutError is obtained from context.Cause(ctx).
+//
+// The diagnostic names the operation, its timeout and the elapsed time, along
+// with a configuration snippet showing how to raise the timeout, and is
+// associated with the attribute for the operation within the "timeouts"
+// attribute or block at the root of the schema.
+func DeadlineExceededDiagnostic(ctx context.Context, err error) diag.Diagnostic {
+	return DeadlineExceededDiagnosticAtPath(ctx, err, path.Root("timeouts"))
+}
+
+// DeadlineExceededDiagnosticAtPath is the same as DeadlineExceededDiagnostic, but
+// associates the diagnostic with the timeouts attribute or block at timeoutsPath.
+func DeadlineExceededDiagnosticAtPath(ctx context.Context, err error, timeoutsPath path.Path) diag.Diagnostic {
+	timeoutErr, ok := timeoutctx.FromError(ctx, err)
+	if !ok {
+		return nil
+	}
+
+	return timeoutdiag.Diagnostic(timeoutErr, timeoutsPath, timeoutdiag.SyntaxUnknown)
+}
@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// FromConfig returns the Value of the "timeouts" attribute or block at the root of
+// the configuration, for providers which read attributes individually rather than into a
+// model containing a Value field. The accessors of the returned Value, such as
+// Read, resolve the timeouts as usual.
+func FromConfig(ctx context.Context, config tfsdk.Config) (Value, diag.Diagnostics) {
+	return FromConfigAtPath(ctx, config, path.Root("timeouts"))
+}
+
+// FromConfigAtPath is the same as FromConfig, but returns the Value of the
+// attribute or block at timeoutsPath.
+func FromConfigAtPath(ctx context.Context, config tfsdk.Config, timeoutsPath path.Path) (Value, diag.Diagnostics) {
+	return core.ValueAtPath(ctx, config.GetAttribute, timeoutsPath)
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Operation identifies an operation whose timeout can be configured.
+type Operation string
+
+const (
+	// OperationRead is the read operation, whose timeout is returned by Read.
+	OperationRead Operation = attributeNameRead
+)
+
+// Request is the framework request type for which OperationForRequest infers
+// the operation.
+type Request interface {
+	datasource.ReadRequest
+}
+
+// OperationForRequest returns the Operation performed by the framework method
+// which receives req, such as OperationRead for datasource.ReadRequest.
+func OperationForRequest[R Request](req R) Operation {
+	switch any(req).(type) {
+	case datasource.ReadRequest:
+		return OperationRead
+	}
+
+	// Unreachable, as the cases above cover every type in Request.
+	return ""
+}
+
+// ForRequest returns the timeout for the operation performed by the framework
+// method which receives req, so that the accessor cannot be mismatched with the
+// method, such as by copying code between methods. If any diagnostics are
+// generated they are returned along with the supplied default timeout.
+func ForRequest[R Request](ctx context.Context, t Value, req R, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.Timeout(ctx, OperationForRequest(req), defaultTimeout)
+}
+
+// Timeout returns the timeout for op, as returned by the accessor for the
+// operation, such as Read for OperationRead. If any diagnostics are generated
+// they are returned along with the supplied default timeout.
+func (t Value) Timeout(ctx context.Context, op Operation, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.getTimeout(ctx, string(op), defaultTimeout)
+}
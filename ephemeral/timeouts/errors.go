@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+// TimeoutError is the cause, returned by context.Cause, of the cancellation of a
+// context returned by the Value context accessors. It records the operation, its
+// timeout, where the timeout was resolved from and the time elapsed before the
+// deadline. It wraps context.DeadlineExceeded, so
+// errors.Is(err, context.DeadlineExceeded) is true.
+//
+// The same type is used by every timeouts package, so errors.As matches a
+// TimeoutError regardless of the package which created the context.
+type TimeoutError = timeoutctx.TimeoutError
+
+// Source describes where a timeout was resolved from.
+type Source = timeoutctx.Source
+
+const (
+	// SourceConfig indicates the timeout was set in the timeouts configuration.
+	SourceConfig = timeoutctx.SourceConfig
+
+	// SourceDefault indicates the timeout was not configured, so a default was
+	// used.
+	SourceDefault = timeoutctx.SourceDefault
+
+	// SourceOverride indicates the timeout was forced by an environment variable.
+	SourceOverride = timeoutctx.SourceOverride
+)
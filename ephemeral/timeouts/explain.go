@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolution"
+)
+
+// Explanation describes the resolution of the timeout for each operation
+// supported by Value, as returned by Explain. String returns a human readable
+// description, while Map returns the explanation as structured log fields.
+type Explanation = resolution.Explanation
+
+// OperationExplanation describes the resolution of the timeout for a single
+// operation, including each step of the fallback chain which was walked.
+type OperationExplanation = resolution.OperationExplanation
+
+// Explain returns the Explanation of the timeout for the "open" operation, for use
+// when debugging. For each operation it contains the configured value, each step
+// of the fallback chain through defaults and environment variable overrides, and
+// the effective timeout.
+//
+// The defaultTimeouts map, keyed by operation name, contains the default timeout
+// which would be supplied to the accessor for each operation. Operations without
+// an entry are explained with a zero default, while operations whose attribute is
+// not present in the Value are not explained. Any diagnostics generated for the
+// operations are returned together.
+func (t Value) Explain(ctx context.Context, defaultTimeouts map[string]time.Duration) (Explanation, diag.Diagnostics) {
+	return t.ExplainAtPath(ctx, defaultTimeouts, path.Root("timeouts"))
+}
+
+// ExplainAtPath is the same as Explain, but the Path of each Resolution is within
+// the timeouts attribute or block at timeoutsPath.
+func (t Value) ExplainAtPath(ctx context.Context, defaultTimeouts map[string]time.Duration, timeoutsPath path.Path) (Explanation, diag.Diagnostics) {
+	return core.Explain(ctx, t, defaultTimeouts, timeoutsPath)
+}
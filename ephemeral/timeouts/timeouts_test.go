@@ -201,7 +201,7 @@ func TestTimeoutsValueOpen(t *testing.T) {
 						"open": types.StringType,
 					},
 					map[string]attr.Value{
-						"open": types.StringValue("10x"),
+						"open": types.StringValue("10y"),
 					},
 				),
 			},
@@ -209,7 +209,7 @@ func TestTimeoutsValueOpen(t *testing.T) {
 			expectedDiags: diag.Diagnostics{
 				diag.NewErrorDiagnostic(
 					"Timeout Cannot Be Parsed",
-					`timeout for "open" cannot be parsed, time: unknown unit "x" in duration "10x"`,
+					`timeout for "open" cannot be parsed, time: unknown unit "y" in duration "10y"`,
 				),
 			},
 		},
@@ -232,3 +232,13 @@ func TestTimeoutsValueOpen(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeoutsValueOperations(t *testing.T) {
+	t.Parallel()
+
+	var value timeouts.Timeouts = timeouts.Value{}
+
+	if diff := cmp.Diff(value.Operations(), []string{"open"}); diff != "" {
+		t.Errorf("unexpected operations difference: %s", diff)
+	}
+}
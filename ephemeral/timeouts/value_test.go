@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+)
+
+func TestNewValue(t *testing.T) {
+	t.Parallel()
+
+	got, diags := timeouts.NewValue(timeouts.Opts{}, map[string]time.Duration{
+		"open": 30 * time.Minute,
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	expected := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"open": types.StringType,
+			},
+			map[string]attr.Value{
+				"open": types.StringValue("30m0s"),
+			},
+		),
+	}
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestNullValue(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.NullValue(timeouts.Opts{})
+
+	expectedType := timeouts.Block(context.Background()).Type()
+
+	if !got.IsNull() {
+		t.Errorf("expected null value, got %s", got)
+	}
+
+	if !got.Type(context.Background()).Equal(expectedType) {
+		t.Errorf("expected type %s, got %s", expectedType, got.Type(context.Background()))
+	}
+}
+
+func TestUnknownValue(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.UnknownValue(timeouts.Opts{})
+
+	if !got.IsUnknown() {
+		t.Errorf("expected unknown value, got %s", got)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Command gencombinations generates a function which composes a base type with
+// any combination of capability types, for wrappers which must implement an
+// optional interface only when the wrapped value does. Go cannot build such
+// types at runtime, as reflect.StructOf does not promote methods, so each
+// combination is an anonymous struct embedding the base pointer and the selected
+// capability types, each of which holds the base pointer as its only field.
+//
+// Usage:
+//
+//	gencombinations -output file.go -package name -function name -base type -result type [-import path] capability...
+//
+// Bit i of the wraps argument of the generated function selects the i-th
+// capability type, so the capabilities must be listed in the order of the bits
+// used by the caller.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+func main() {
+	output := flag.String("output", "", "file to write")
+	pkg := flag.String("package", "", "package of the generated file")
+	function := flag.String("function", "", "name of the generated function")
+	base := flag.String("base", "", "type whose pointer is composed with the capability types")
+	result := flag.String("result", "", "type returned by the generated function")
+	importPath := flag.String("import", "", "import path required by the result type")
+
+	flag.Parse()
+
+	capabilities := flag.Args()
+
+	if *output == "" || *pkg == "" || *function == "" || *base == "" || *result == "" || len(capabilities) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	src, err := generate(*pkg, *function, *base, *result, *importPath, capabilities)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted source of the function composing base with
+// each combination of capabilities.
+func generate(pkg, function, base, result, importPath string, capabilities []string) ([]byte, error) {
+	var b bytes.Buffer
+
+	fmt.Fprint(&b, "// Copyright IBM Corp. 2022, 2026\n// SPDX-License-Identifier: MPL-2.0\n\n")
+	fmt.Fprintf(&b, "// Code generated by gencombinations; DO NOT EDIT.\n\npackage %s\n\n", pkg)
+
+	if importPath != "" {
+		fmt.Fprintf(&b, "import %q\n\n", importPath)
+	}
+
+	fmt.Fprintf(&b, "// %s returns base composed with the capability types\n", function)
+	fmt.Fprint(&b, "// selected by wraps, in which bit i selects the i-th of:\n//\n")
+
+	for _, capability := range capabilities {
+		fmt.Fprintf(&b, "//   - %s\n", capability)
+	}
+
+	fmt.Fprintf(&b, "func %s(base *%s, wraps int) %s {\n\tswitch wraps {\n", function, base, result)
+
+	for wraps := 1; wraps < 1<<len(capabilities); wraps++ {
+		var selected []string
+
+		for i, capability := range capabilities {
+			if wraps&(1<<i) != 0 {
+				selected = append(selected, capability)
+			}
+		}
+
+		fmt.Fprintf(&b, "\tcase 0b%0*b:\n\t\treturn struct {\n\t\t\t*%s\n", len(capabilities), wraps, base)
+
+		for _, capability := range selected {
+			fmt.Fprintf(&b, "\t\t\t%s\n", capability)
+		}
+
+		fmt.Fprint(&b, "\t\t}{\n\t\t\tbase,\n")
+
+		for _, capability := range selected {
+			fmt.Fprintf(&b, "\t\t\t%s{base},\n", capability)
+		}
+
+		fmt.Fprint(&b, "\t\t}\n")
+	}
+
+	fmt.Fprint(&b, "\t}\n\n\treturn base\n}\n")
+
+	return format.Source(b.Bytes())
+}
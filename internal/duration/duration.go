@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package duration
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// None is returned by Parse for the "none" keyword, indicating that no
+	// timeout should be applied. It is the maximum time.Duration, so that it
+	// remains safe to use with context.WithTimeout.
+	None time.Duration = math.MaxInt64
+
+	// keywordDefault refers to the default timeout within relative expressions.
+	keywordDefault = "default"
+
+	// keywordNone indicates that no timeout should be applied.
+	keywordNone = "none"
+
+	suffixMultiple   = "x"
+	suffixPercentage = "%"
+)
+
+// Parse parses s as a timeout. In addition to any string which can be parsed as
+// time.Duration, such as "30s" or "2h45m", the following expressions relative to
+// the supplied default timeout are accepted:
+//
+//   - "default": the default timeout.
+//   - "default+10m" or "default-10m": the default timeout plus or minus a duration.
+//   - "2x" or "1.5x": a multiple of the default timeout.
+//   - "150%": a percentage of the default timeout.
+//
+// The "none" keyword is also accepted if allowNone is true, for which None is
+// returned. If the default timeout is None, relative expressions also resolve to
+// None.
+//
+// An error is returned if s cannot be parsed, if it is "none" and allowNone is
+// false, or if a relative expression resolves to a duration of zero or less.
+func Parse(s string, defaultTimeout time.Duration, allowNone bool) (time.Duration, error) {
+	expr, err := parse(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if expr.none && !allowNone {
+		return 0, fmt.Errorf("timeout %q is not supported", s)
+	}
+
+	return expr.resolve(defaultTimeout)
+}
+
+// String returns d formatted as by time.Duration, or "none" if d is None.
+func String(d time.Duration) string {
+	if d == None {
+		return keywordNone
+	}
+
+	return d.String()
+}
+
+// Validate returns an error if s cannot be parsed as a timeout, including a
+// multiple or percentage of the default timeout which is not greater than zero.
+// Validation does not require the default timeout, so expressions such as
+// "default-5m", which could resolve to a duration of zero or less, are only
+// reported by Parse. The "none" keyword is only accepted if allowNone is true.
+func Validate(s string, allowNone bool) error {
+	expr, err := parse(s)
+	if err != nil {
+		return err
+	}
+
+	if expr.none && !allowNone {
+		return fmt.Errorf("timeout %q is not supported", s)
+	}
+
+	return nil
+}
+
+// expression is a parsed timeout, which is either an absolute duration or an
+// expression relative to the default timeout.
+type expression struct {
+	none     bool
+	relative bool
+
+	// absolute is the duration of an absolute expression, or the duration added
+	// to the default timeout of a relative expression.
+	absolute time.Duration
+
+	// factor is the multiple of the default timeout of a relative expression.
+	factor float64
+
+	source string
+}
+
+func (e expression) resolve(defaultTimeout time.Duration) (time.Duration, error) {
+	if e.none {
+		return None, nil
+	}
+
+	if !e.relative {
+		return e.absolute, nil
+	}
+
+	if defaultTimeout == None {
+		return None, nil
+	}
+
+	timeout := time.Duration(float64(defaultTimeout)*e.factor) + e.absolute
+
+	if timeout <= 0 {
+		return 0, fmt.Errorf("relative timeout %q resolves to %s, which is not greater than zero, with default %s", e.source, timeout, defaultTimeout)
+	}
+
+	return timeout, nil
+}
+
+func parse(s string) (expression, error) {
+	switch {
+	case s == keywordNone:
+		return expression{none: true, source: s}, nil
+	case s == keywordDefault:
+		return expression{relative: true, factor: 1, source: s}, nil
+	case strings.HasPrefix(s, keywordDefault+"+"), strings.HasPrefix(s, keywordDefault+"-"):
+		offset, err := time.ParseDuration(strings.TrimPrefix(s, keywordDefault))
+		if err != nil {
+			return expression{}, fmt.Errorf("relative timeout %q cannot be parsed, %w", s, err)
+		}
+
+		return expression{relative: true, factor: 1, absolute: offset, source: s}, nil
+	case strings.HasSuffix(s, suffixMultiple):
+		factor, err := parseFactor(s, suffixMultiple)
+		if err != nil {
+			return expression{}, err
+		}
+
+		return expression{relative: true, factor: factor, source: s}, nil
+	case strings.HasSuffix(s, suffixPercentage):
+		factor, err := parseFactor(s, suffixPercentage)
+		if err != nil {
+			return expression{}, err
+		}
+
+		return expression{relative: true, factor: factor / 100, source: s}, nil
+	}
+
+	timeout, err := time.ParseDuration(s)
+	if err != nil {
+		return expression{}, err
+	}
+
+	return expression{absolute: timeout, source: s}, nil
+}
+
+func parseFactor(s string, suffix string) (float64, error) {
+	factor, err := strconv.ParseFloat(strings.TrimSuffix(s, suffix), 64)
+	if err != nil {
+		return 0, fmt.Errorf("relative timeout %q cannot be parsed, %q is not a number", s, strings.TrimSuffix(s, suffix))
+	}
+
+	if math.IsNaN(factor) || math.IsInf(factor, 0) {
+		return 0, fmt.Errorf("relative timeout %q cannot be parsed, %q is not a number", s, strings.TrimSuffix(s, suffix))
+	}
+
+	if factor <= 0 {
+		return 0, fmt.Errorf("relative timeout %q cannot be parsed, %q must be greater than zero", s, strings.TrimSuffix(s, suffix))
+	}
+
+	return factor, nil
+}
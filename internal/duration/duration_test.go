@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package duration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input           string
+		expectedTimeout time.Duration
+		expectedErr     string
+	}
+	tests := map[string]testCase{
+		"absolute": {
+			input:           "2h45m",
+			expectedTimeout: 2*time.Hour + 45*time.Minute,
+		},
+		"default": {
+			input:           "default",
+			expectedTimeout: 20 * time.Minute,
+		},
+		"default-plus": {
+			input:           "default+10m",
+			expectedTimeout: 30 * time.Minute,
+		},
+		"default-minus": {
+			input:           "default-5m",
+			expectedTimeout: 15 * time.Minute,
+		},
+		"default-minus-negative": {
+			input:       "default-30m",
+			expectedErr: `relative timeout "default-30m" resolves to -10m0s, which is not greater than zero, with default 20m0s`,
+		},
+		"default-minus-zero": {
+			input:       "default-20m",
+			expectedErr: `relative timeout "default-20m" resolves to 0s, which is not greater than zero, with default 20m0s`,
+		},
+		"default-plus-invalid": {
+			input:       "default+10y",
+			expectedErr: `relative timeout "default+10y" cannot be parsed, time: unknown unit "y" in duration "+10y"`,
+		},
+		"multiple": {
+			input:           "2x",
+			expectedTimeout: 40 * time.Minute,
+		},
+		"multiple-fraction": {
+			input:           "1.5x",
+			expectedTimeout: 30 * time.Minute,
+		},
+		"multiple-invalid": {
+			input:       "twox",
+			expectedErr: `relative timeout "twox" cannot be parsed, "two" is not a number`,
+		},
+		"multiple-negative": {
+			input:       "-2x",
+			expectedErr: `relative timeout "-2x" cannot be parsed, "-2" must be greater than zero`,
+		},
+		"multiple-zero": {
+			input:       "0x",
+			expectedErr: `relative timeout "0x" cannot be parsed, "0" must be greater than zero`,
+		},
+		"percentage-zero": {
+			input:       "0%",
+			expectedErr: `relative timeout "0%" cannot be parsed, "0" must be greater than zero`,
+		},
+		"multiple-not-a-number": {
+			input:       "NaNx",
+			expectedErr: `relative timeout "NaNx" cannot be parsed, "NaN" is not a number`,
+		},
+		"percentage": {
+			input:           "150%",
+			expectedTimeout: 30 * time.Minute,
+		},
+		"none": {
+			input:           "none",
+			expectedTimeout: duration.None,
+		},
+		"invalid": {
+			input:       "10y",
+			expectedErr: `time: unknown unit "y" in duration "10y"`,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotTimeout, gotErr := duration.Parse(test.input, 20*time.Minute, true)
+
+			if diff := cmp.Diff(gotTimeout, test.expectedTimeout); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+
+			var gotErrString string
+			if gotErr != nil {
+				gotErrString = gotErr.Error()
+			}
+
+			if diff := cmp.Diff(gotErrString, test.expectedErr); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+
+			if gotErr == nil {
+				if err := duration.Validate(test.input, true); err != nil {
+					t.Errorf("unexpected validation error: %s", err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseDefaultNone(t *testing.T) {
+	t.Parallel()
+
+	got, err := duration.Parse("2x", duration.None, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != duration.None {
+		t.Errorf("expected %s, got %s", duration.None, got)
+	}
+}
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	if got, expected := duration.String(90*time.Minute), "1h30m0s"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if got, expected := duration.String(duration.None), "none"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	if err := duration.Validate("none", false); err == nil {
+		t.Errorf("expected error for none when not allowed")
+	}
+
+	if err := duration.Validate("none", true); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := duration.Validate("0x", true); err == nil {
+		t.Errorf("expected error for a zero multiple")
+	}
+}
+
+func TestParseNoneNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	_, err := duration.Parse("none", 20*time.Minute, false)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if diff := cmp.Diff(err.Error(), `timeout "none" is not supported`); diff != "" {
+		t.Errorf("unexpected err difference: %s", diff)
+	}
+}
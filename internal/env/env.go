@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package env
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/explain"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/logging"
+)
+
+const (
+	// Multiplier is the name of the environment variable which, when set, is
+	// parsed as a positive floating point number by which all timeouts are
+	// multiplied, such as "3" or "0.5".
+	Multiplier = "TF_TIMEOUTS_MULTIPLIER"
+
+	// operationPrefix is the prefix of the per-operation environment variables
+	// which, when set, force the timeout for the operation, such as
+	// TF_TIMEOUTS_CREATE.
+	operationPrefix = "TF_TIMEOUTS_"
+)
+
+// Operation returns the name of the environment variable which, when set, forces
+// the timeout for the named operation, such as TF_TIMEOUTS_CREATE for "create".
+func Operation(operation string) string {
+	return operationPrefix + strings.ToUpper(operation)
+}
+
+// Override returns the timeout forced for the named operation by its environment
+// variable, and whether the environment variable is set. An error is returned if
+// the value cannot be parsed as a time.Duration greater than zero.
+func Override(operation string) (time.Duration, bool, error) {
+	name := Operation(operation)
+
+	value, ok := os.LookupEnv(name)
+	if !ok || value == "" {
+		return 0, false, nil
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, false, fmt.Errorf("timeout for %q cannot be parsed from the %s environment variable, %w", operation, name, err)
+	}
+
+	if timeout <= 0 {
+		return 0, false, fmt.Errorf("timeout for %q from the %s environment variable must be greater than zero, got %s", operation, name, value)
+	}
+
+	return timeout, true, nil
+}
+
+// GetMultiplier returns the value of the Multiplier environment variable, and
+// whether it is set. An error is returned if the value cannot be parsed as a
+// finite floating point number greater than zero.
+func GetMultiplier() (float64, bool, error) {
+	value, ok := os.LookupEnv(Multiplier)
+	if !ok || value == "" {
+		return 1, false, nil
+	}
+
+	multiplier, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 1, false, fmt.Errorf("timeout multiplier cannot be parsed from the %s environment variable, %w", Multiplier, err)
+	}
+
+	if math.IsNaN(multiplier) || math.IsInf(multiplier, 0) || multiplier <= 0 {
+		return 1, false, fmt.Errorf("timeout multiplier from the %s environment variable must be a finite number greater than zero, got %s", Multiplier, value)
+	}
+
+	return multiplier, true, nil
+}
+
+// Apply returns the timeout for the named operation after applying environment
+// variable overrides, and whether the timeout was forced by a per-operation
+// override. A per-operation override replaces the supplied timeout and is returned
+// as-is. Otherwise, if a multiplier is set, the supplied timeout is multiplied by
+// it, unless it is duration.None. If an environment variable cannot be parsed, or
+// the multiplied timeout exceeds the maximum time.Duration, the error is returned
+// along with the supplied timeout.
+func Apply(ctx context.Context, operation string, timeout time.Duration) (time.Duration, bool, error) {
+	override, ok, err := Override(operation)
+	if err != nil {
+		return timeout, false, err
+	}
+
+	if ok {
+		logging.Info(ctx, operation+" timeout overridden by environment variable", map[string]interface{}{
+			logging.KeyOperation: operation,
+			logging.KeyEnv:       Operation(operation),
+			logging.KeyTimeout:   override.String(),
+		})
+		explain.Record(ctx, "environment variable %s overrides timeout with %s", Operation(operation), override)
+
+		return override, true, nil
+	}
+
+	multiplier, ok, err := GetMultiplier()
+	if err != nil {
+		return timeout, false, err
+	}
+
+	if !ok || timeout == duration.None {
+		return timeout, false, nil
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63, which itself overflows.
+	product := float64(timeout) * multiplier
+	if product >= float64(math.MaxInt64) {
+		return timeout, false, fmt.Errorf("timeout for %q of %s multiplied by %g from the %s environment variable exceeds the maximum duration", operation, timeout, multiplier, Multiplier)
+	}
+
+	multiplied := time.Duration(product)
+
+	logging.Info(ctx, operation+" timeout multiplied by environment variable", map[string]interface{}{
+		logging.KeyOperation:  operation,
+		logging.KeyEnv:        Multiplier,
+		logging.KeyMultiplier: multiplier,
+		logging.KeyTimeout:    multiplied.String(),
+	})
+	explain.Record(ctx, "environment variable %s multiplies timeout by %g to %s", Multiplier, multiplier, multiplied)
+
+	return multiplied, false, nil
+}
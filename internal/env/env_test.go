@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package env_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/env"
+)
+
+//nolint:paralleltest // t.Setenv cannot be used in parallel tests
+func TestApply(t *testing.T) {
+	type testCase struct {
+		override           string
+		multiplier         string
+		expectedTimeout    time.Duration
+		expectedOverridden bool
+		expectedErr        string
+	}
+	tests := map[string]testCase{
+		"not-set": {
+			expectedTimeout: 20 * time.Minute,
+		},
+		"override": {
+			override:           "45m",
+			expectedTimeout:    45 * time.Minute,
+			expectedOverridden: true,
+		},
+		"override-not-multiplied": {
+			override:           "45m",
+			multiplier:         "3",
+			expectedTimeout:    45 * time.Minute,
+			expectedOverridden: true,
+		},
+		"multiplier": {
+			multiplier:      "3",
+			expectedTimeout: time.Hour,
+		},
+		"multiplier-fraction": {
+			multiplier:      "0.5",
+			expectedTimeout: 10 * time.Minute,
+		},
+		"override-not-parseable-as-time-duration": {
+			override:        "10x",
+			expectedTimeout: 20 * time.Minute,
+			expectedErr:     `timeout for "create" cannot be parsed from the TF_TIMEOUTS_CREATE environment variable, time: unknown unit "x" in duration "10x"`,
+		},
+		"multiplier-not-parseable": {
+			multiplier:      "three",
+			expectedTimeout: 20 * time.Minute,
+			expectedErr:     `timeout multiplier cannot be parsed from the TF_TIMEOUTS_MULTIPLIER environment variable, strconv.ParseFloat: parsing "three": invalid syntax`,
+		},
+		"multiplier-not-positive": {
+			multiplier:      "-2",
+			expectedTimeout: 20 * time.Minute,
+			expectedErr:     `timeout multiplier from the TF_TIMEOUTS_MULTIPLIER environment variable must be a finite number greater than zero, got -2`,
+		},
+		"multiplier-nan": {
+			multiplier:      "NaN",
+			expectedTimeout: 20 * time.Minute,
+			expectedErr:     `timeout multiplier from the TF_TIMEOUTS_MULTIPLIER environment variable must be a finite number greater than zero, got NaN`,
+		},
+		"multiplier-inf": {
+			multiplier:      "Inf",
+			expectedTimeout: 20 * time.Minute,
+			expectedErr:     `timeout multiplier from the TF_TIMEOUTS_MULTIPLIER environment variable must be a finite number greater than zero, got Inf`,
+		},
+		"multiplier-overflow": {
+			multiplier:      "1e300",
+			expectedTimeout: 20 * time.Minute,
+			expectedErr:     `timeout for "create" of 20m0s multiplied by 1e+300 from the TF_TIMEOUTS_MULTIPLIER environment variable exceeds the maximum duration`,
+		},
+		"override-negative": {
+			override:        "-5m",
+			expectedTimeout: 20 * time.Minute,
+			expectedErr:     `timeout for "create" from the TF_TIMEOUTS_CREATE environment variable must be greater than zero, got -5m`,
+		},
+		"override-zero": {
+			override:        "0s",
+			expectedTimeout: 20 * time.Minute,
+			expectedErr:     `timeout for "create" from the TF_TIMEOUTS_CREATE environment variable must be greater than zero, got 0s`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(env.Operation("create"), test.override)
+			t.Setenv(env.Multiplier, test.multiplier)
+
+			gotTimeout, gotOverridden, gotErr := env.Apply(context.Background(), "create", 20*time.Minute)
+
+			if diff := cmp.Diff(gotTimeout, test.expectedTimeout); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotOverridden, test.expectedOverridden); diff != "" {
+				t.Errorf("unexpected overridden difference: %s", diff)
+			}
+
+			var gotErrString string
+			if gotErr != nil {
+				gotErrString = gotErr.Error()
+			}
+
+			if diff := cmp.Diff(gotErrString, test.expectedErr); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOperation(t *testing.T) {
+	t.Parallel()
+
+	if got, expected := env.Operation("invoke"), "TF_TIMEOUTS_INVOKE"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
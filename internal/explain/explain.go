@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package explain
+
+import (
+	"context"
+	"fmt"
+)
+
+// traceContextKey is the context key under which a trace is stored.
+type traceContextKey struct{}
+
+// trace records the steps taken while resolving a timeout.
+type trace struct {
+	steps []string
+}
+
+// ContextWithTrace returns a copy of ctx in which the steps taken while
+// resolving a timeout are recorded by Record, for retrieval with Steps.
+func ContextWithTrace(ctx context.Context) context.Context {
+	return context.WithValue(ctx, traceContextKey{}, &trace{})
+}
+
+// Record formats a step taken while resolving a timeout and records it in the
+// trace stored in ctx by ContextWithTrace. If no trace is stored, Record does
+// nothing.
+func Record(ctx context.Context, format string, args ...interface{}) {
+	t, ok := ctx.Value(traceContextKey{}).(*trace)
+	if !ok {
+		return
+	}
+
+	t.steps = append(t.steps, fmt.Sprintf(format, args...))
+}
+
+// Steps returns the steps recorded in the trace stored in ctx by
+// ContextWithTrace, or nil if no trace is stored.
+func Steps(ctx context.Context) []string {
+	t, ok := ctx.Value(traceContextKey{}).(*trace)
+	if !ok {
+		return nil
+	}
+
+	return t.steps
+}
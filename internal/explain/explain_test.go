@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package explain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/explain"
+)
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	explain.Record(context.Background(), "not recorded")
+
+	if got := explain.Steps(context.Background()); got != nil {
+		t.Errorf("expected no steps, got %v", got)
+	}
+
+	ctx := explain.ContextWithTrace(context.Background())
+
+	explain.Record(ctx, "default %s", "20m0s")
+	explain.Record(ctx, "configured %q", "2x")
+
+	expected := []string{
+		"default 20m0s",
+		`configured "2x"`,
+	}
+
+	if diff := cmp.Diff(explain.Steps(ctx), expected); diff != "" {
+		t.Errorf("unexpected steps difference: %s", diff)
+	}
+}
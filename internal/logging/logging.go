@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// SubsystemName is the tflog subsystem name for all timeouts logging. Logs
+	// are emitted with the "provider.timeouts" module.
+	SubsystemName = "timeouts"
+
+	// EnvTfLogProviderTimeouts is the environment variable which sets the level
+	// of the timeouts subsystem, such as "TRACE". If unset, the level of the
+	// provider root logger is used.
+	EnvTfLogProviderTimeouts = "TF_LOG_PROVIDER_TIMEOUTS"
+
+	// KeyOperation is the field key for the name of the operation, such as
+	// "create".
+	KeyOperation = "tf_timeouts_operation"
+
+	// KeyValue is the field key for the configured value of the timeout, such as
+	// "30m".
+	KeyValue = "tf_timeouts_value"
+
+	// KeySource is the field key for where the timeout was resolved from.
+	KeySource = "tf_timeouts_source"
+
+	// KeyTimeout is the field key for the resolved timeout.
+	KeyTimeout = "tf_timeouts_timeout"
+
+	// KeyEnv is the field key for the name of an environment variable which
+	// modified the timeout.
+	KeyEnv = "tf_timeouts_env"
+
+	// KeyMultiplier is the field key for the timeout multiplier.
+	KeyMultiplier = "tf_timeouts_multiplier"
+
+	// KeyDeadline is the field key for the absolute deadline of an operation.
+	KeyDeadline = "tf_timeouts_deadline"
+
+	// KeyResourceType is the field key for the resource type, matching the key
+	// used by terraform-plugin-framework.
+	KeyResourceType = "tf_resource_type"
+)
+
+// initializedContextKey marks a context in which the timeouts subsystem has
+// been created.
+type initializedContextKey struct{}
+
+// InitContext returns a copy of ctx in which the timeouts subsystem logger has
+// been created, inheriting the fields of the provider root logger, such as
+// tf_resource_type. If the subsystem has already been created, ctx is returned
+// unchanged.
+func InitContext(ctx context.Context) context.Context {
+	if ctx.Value(initializedContextKey{}) != nil {
+		return ctx
+	}
+
+	ctx = tflog.NewSubsystem(ctx, SubsystemName,
+		// All calls are through the functions in this package.
+		tflog.WithAdditionalLocationOffset(1),
+		tflog.WithLevelFromEnv(EnvTfLogProviderTimeouts),
+		tflog.WithRootFields(),
+	)
+
+	return context.WithValue(ctx, initializedContextKey{}, true)
+}
+
+// SetField returns a copy of ctx in which the timeouts subsystem logger includes
+// the field in all subsequent logs.
+func SetField(ctx context.Context, key string, value interface{}) context.Context {
+	return tflog.SubsystemSetField(InitContext(ctx), SubsystemName, key, value)
+}
+
+// Trace logs msg at TRACE level to the timeouts subsystem.
+func Trace(ctx context.Context, msg string, additionalFields ...map[string]interface{}) {
+	tflog.SubsystemTrace(InitContext(ctx), SubsystemName, msg, additionalFields...)
+}
+
+// Debug logs msg at DEBUG level to the timeouts subsystem.
+func Debug(ctx context.Context, msg string, additionalFields ...map[string]interface{}) {
+	tflog.SubsystemDebug(InitContext(ctx), SubsystemName, msg, additionalFields...)
+}
+
+// Info logs msg at INFO level to the timeouts subsystem.
+func Info(ctx context.Context, msg string, additionalFields ...map[string]interface{}) {
+	tflog.SubsystemInfo(InitContext(ctx), SubsystemName, msg, additionalFields...)
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/logging"
+)
+
+func TestSubsystem(t *testing.T) {
+	t.Setenv(logging.EnvTfLogProviderTimeouts, "")
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = tflog.SetField(ctx, logging.KeyResourceType, "example_thing")
+
+	logging.Debug(ctx, "resolved create timeout", map[string]interface{}{
+		logging.KeyOperation: "create",
+		logging.KeySource:    "config",
+	})
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to read log entries: %s", err)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"@level":                "debug",
+			"@message":              "resolved create timeout",
+			"@module":               "provider.timeouts",
+			logging.KeyOperation:    "create",
+			logging.KeyResourceType: "example_thing",
+			logging.KeySource:       "config",
+		},
+	}
+
+	if diff := cmp.Diff(entries, expected); diff != "" {
+		t.Errorf("unexpected log entries difference: %s", diff)
+	}
+}
+
+func TestSubsystemLevelFromEnv(t *testing.T) {
+	t.Setenv(logging.EnvTfLogProviderTimeouts, "WARN")
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	logging.Trace(ctx, "trace")
+	logging.Debug(ctx, "debug")
+	logging.Info(ctx, "info")
+
+	if output.Len() != 0 {
+		t.Errorf("expected no log entries, got %s", output.String())
+	}
+}
+
+func TestSetField(t *testing.T) {
+	t.Setenv(logging.EnvTfLogProviderTimeouts, "")
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = logging.SetField(ctx, logging.KeyResourceType, "example_thing")
+
+	logging.Info(ctx, "message")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to read log entries: %s", err)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"@level":                "info",
+			"@message":              "message",
+			"@module":               "provider.timeouts",
+			logging.KeyResourceType: "example_thing",
+		},
+	}
+
+	if diff := cmp.Diff(entries, expected); diff != "" {
+		t.Errorf("unexpected log entries difference: %s", diff)
+	}
+}
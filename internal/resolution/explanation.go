@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resolution
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+)
+
+// Explanation describes the resolution of the timeout for each operation
+// supported by a Value, for use when debugging.
+type Explanation struct {
+	// Operations contains the explanation of each supported operation, in the
+	// order in which the operations are declared by the package.
+	Operations []OperationExplanation
+}
+
+// OperationExplanation describes the resolution of the timeout for an
+// operation, including each step of the fallback chain which was walked.
+type OperationExplanation struct {
+	Resolution
+
+	// Steps describes each step taken while resolving the timeout, in order.
+	Steps []string
+}
+
+// String returns a human readable description of the explanation, with a line
+// for each operation followed by an indented line for each step.
+func (e Explanation) String() string {
+	var b strings.Builder
+
+	for _, o := range e.Operations {
+		fmt.Fprintf(&b, "%s: %s (%s", o.Operation, duration.String(o.Timeout), o.Source)
+
+		if o.Raw != "" {
+			fmt.Fprintf(&b, ", configured %q", o.Raw)
+		}
+
+		b.WriteString(")\n")
+
+		for _, step := range o.Steps {
+			fmt.Fprintf(&b, "  - %s\n", step)
+		}
+	}
+
+	return b.String()
+}
+
+// Map returns the explanation as a map keyed by operation name, suitable for use
+// as structured log fields. Each value is a map containing the "timeout",
+// "source", "raw", "path" and "steps" of the operation.
+func (e Explanation) Map() map[string]interface{} {
+	m := make(map[string]interface{}, len(e.Operations))
+
+	for _, o := range e.Operations {
+		m[o.Operation] = map[string]interface{}{
+			"timeout": duration.String(o.Timeout),
+			"source":  string(o.Source),
+			"raw":     o.Raw,
+			"path":    o.Path.String(),
+			"steps":   o.Steps,
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resolution_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolution"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+var testExplanation = resolution.Explanation{
+	Operations: []resolution.OperationExplanation{
+		{
+			Resolution: resolution.Resolution{
+				Operation: "create",
+				Timeout:   40 * time.Minute,
+				Source:    timeoutctx.SourceConfig,
+				Raw:       "2x",
+				Path:      path.Root("timeouts").AtName("create"),
+			},
+			Steps: []string{
+				"default 20m0s",
+				`configuration "2x" resolves to 40m0s`,
+			},
+		},
+		{
+			Resolution: resolution.Resolution{
+				Operation: "read",
+				Timeout:   duration.None,
+				Source:    timeoutctx.SourceDefault,
+				Path:      path.Root("timeouts").AtName("read"),
+			},
+			Steps: []string{
+				"default none",
+				"configuration not found, using default",
+			},
+		},
+	},
+}
+
+func TestExplanationString(t *testing.T) {
+	t.Parallel()
+
+	expected := `create: 40m0s (config, configured "2x")
+  - default 20m0s
+  - configuration "2x" resolves to 40m0s
+read: none (default)
+  - default none
+  - configuration not found, using default
+`
+
+	if diff := cmp.Diff(testExplanation.String(), expected); diff != "" {
+		t.Errorf("unexpected string difference: %s", diff)
+	}
+}
+
+func TestExplanationMap(t *testing.T) {
+	t.Parallel()
+
+	expected := map[string]interface{}{
+		"create": map[string]interface{}{
+			"timeout": "40m0s",
+			"source":  "config",
+			"raw":     "2x",
+			"path":    "timeouts.create",
+			"steps": []string{
+				"default 20m0s",
+				`configuration "2x" resolves to 40m0s`,
+			},
+		},
+		"read": map[string]interface{}{
+			"timeout": "none",
+			"source":  "default",
+			"raw":     "",
+			"path":    "timeouts.read",
+			"steps": []string{
+				"default none",
+				"configuration not found, using default",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(testExplanation.Map(), expected); diff != "" {
+		t.Errorf("unexpected map difference: %s", diff)
+	}
+}
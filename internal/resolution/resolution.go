@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resolution
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+// Resolution describes the timeout resolved for an operation, and where it was
+// resolved from.
+type Resolution struct {
+	// Operation is the name of the operation, such as "create".
+	Operation string
+
+	// Timeout is the effective timeout for the operation, after defaults and
+	// environment variable overrides have been applied.
+	Timeout time.Duration
+
+	// Source is where Timeout was resolved from.
+	Source timeoutctx.Source
+
+	// Raw is the configured value of the attribute for the operation, such as
+	// "30m" or "2x". It is empty if the attribute is not defined, null or unknown.
+	Raw string
+
+	// Path is the path of the attribute for the operation, within the path of the
+	// timeouts attribute or block supplied by the caller, which is "timeouts" at the
+	// root of the schema unless an AtPath variant is used. It is empty if the
+	// timeout has no attribute, such as for import or a Value returned by Convert.
+	Path path.Path
+}
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package resolver resolves the timeout of an operation from the raw string
+// attributes of a timeouts object, applying defaults, the "default" attribute,
+// "none" and environment variable overrides. It does not depend on the
+// framework, so that the framework packages, which convert their types.Object to
+// Attributes, and the protocol packages, which convert their tftypes.Value, share
+// the same rules.
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/env"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/explain"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+// Attributes are the string attributes of a timeouts object keyed by name. An
+// attribute which is defined but null or unknown has a nil value, and one which
+// is not defined is absent.
+type Attributes map[string]*string
+
+// Result describes the timeout resolved for an operation, and where it was
+// resolved from.
+type Result struct {
+	// Operation is the name of the operation, such as "create".
+	Operation string
+
+	// Timeout is the effective timeout for the operation, after defaults and
+	// environment variable overrides have been applied.
+	Timeout time.Duration
+
+	// Source is where Timeout was resolved from.
+	Source timeoutctx.Source
+
+	// Raw is the configured value of the attribute for the operation, such as
+	// "30m" or "2x". It is empty if the attribute is not defined, null or unknown.
+	Raw string
+
+	// Attribute is the name of the attribute for the operation, which is the
+	// DefaultAttribute if it applied. It is empty if the operation cannot be
+	// configured, such as for import.
+	Attribute string
+}
+
+// Core resolves the timeouts of a set of operations.
+type Core struct {
+	// Operations are the names of the operations whose timeouts can be
+	// configured.
+	Operations []string
+
+	// ProviderDefault, if set, returns the default timeout of an operation which
+	// has not been configured, in place of the default supplied to Resolve, along
+	// with the Source of the default. It is responsible for recording the default
+	// in any explanation.
+	ProviderDefault func(ctx context.Context, name string, defaultTimeout time.Duration) (time.Duration, timeoutctx.Source)
+
+	// DefaultAttribute, if set, is the name of an attribute whose value applies to
+	// each of DefaultOperations which has not been configured.
+	DefaultAttribute string
+
+	// DefaultOperations are the names of the operations to which DefaultAttribute
+	// applies.
+	DefaultOperations []string
+
+	// AllowNone indicates whether a timeout may be set to "none", for which
+	// duration.None is returned. Otherwise "none" cannot be parsed.
+	AllowNone bool
+}
+
+// Resolve returns the timeout for the named operation from attributes along with
+// where it was resolved from. If the operation is not one of Operations, the
+// supplied default timeout is returned unchanged, as the operation cannot be
+// configured. Any errors generated are joined and returned along with the
+// Result, each of which is a value which cannot be parsed.
+func (c Core) Resolve(ctx context.Context, attributes Attributes, name string, defaultTimeout time.Duration) (Result, error) {
+	if !slices.Contains(c.Operations, name) {
+		return Result{
+			Operation: name,
+			Timeout:   defaultTimeout,
+			Source:    timeoutctx.SourceDefault,
+		}, nil
+	}
+
+	ctx = logging.InitContext(ctx)
+
+	r, err := c.configured(ctx, attributes, name, defaultTimeout)
+
+	return c.override(ctx, r, err)
+}
+
+// ResolveUnconfigured returns the timeout for the named operation which cannot be
+// configured, such as "import", along with where it was resolved from. The
+// supplied default timeout is replaced by any ProviderDefault, and environment
+// variable overrides are applied, as for Resolve. The Raw and Attribute fields of
+// the Result are always empty.
+func (c Core) ResolveUnconfigured(ctx context.Context, name string, defaultTimeout time.Duration) (Result, error) {
+	ctx = logging.InitContext(ctx)
+
+	r := Result{
+		Operation: name,
+	}
+
+	r.Timeout, r.Source = c.defaultTimeout(ctx, name, defaultTimeout)
+
+	return c.override(ctx, r, nil)
+}
+
+// configured returns the timeout for the named operation set in attributes, or
+// the default timeout if it has not been configured.
+func (c Core) configured(ctx context.Context, attributes Attributes, name string, defaultTimeout time.Duration) (Result, error) {
+	defaultTimeout, source := c.defaultTimeout(ctx, name, defaultTimeout)
+
+	r := Result{
+		Operation: name,
+		Timeout:   defaultTimeout,
+		Source:    source,
+		Attribute: name,
+	}
+
+	value, ok := attributes[name]
+
+	if value == nil {
+		defaultValue := attributes[c.DefaultAttribute]
+
+		switch {
+		case c.DefaultAttribute != "" && slices.Contains(c.DefaultOperations, name) && defaultValue != nil:
+			explain.Record(ctx, "configuration not set, using the %q attribute", c.DefaultAttribute)
+
+			value = defaultValue
+			r.Attribute = c.DefaultAttribute
+		case !ok:
+			logging.Trace(ctx, name+" timeout configuration not found, using provided default", map[string]interface{}{
+				logging.KeyOperation: name,
+			})
+			explain.Record(ctx, "configuration not found, using default")
+
+			return r, nil
+		default:
+			logging.Trace(ctx, name+" timeout configuration is null or unknown, using provided default", map[string]interface{}{
+				logging.KeyOperation: name,
+			})
+			explain.Record(ctx, "configuration is null or unknown, using default")
+
+			return r, nil
+		}
+	}
+
+	r.Raw = *value
+
+	timeout, err := duration.Parse(r.Raw, defaultTimeout, c.AllowNone)
+	if err != nil {
+		explain.Record(ctx, "configuration %q cannot be parsed, using default", r.Raw)
+
+		return r, fmt.Errorf("timeout for %q cannot be parsed, %w", name, err)
+	}
+
+	explain.Record(ctx, "configuration %q resolves to %s", r.Raw, duration.String(timeout))
+
+	r.Timeout = timeout
+	r.Source = timeoutctx.SourceConfig
+
+	return r, nil
+}
+
+// override applies any environment variable overrides to r, which is returned
+// along with err joined with any error generated.
+func (c Core) override(ctx context.Context, r Result, err error) (Result, error) {
+	timeout, overridden, envErr := env.Apply(ctx, r.Operation, r.Timeout)
+	if envErr != nil {
+		err = errors.Join(err, envErr)
+
+		explain.Record(ctx, "environment variables cannot be parsed, keeping %s", duration.String(r.Timeout))
+	}
+
+	r.Timeout = timeout
+
+	if overridden {
+		r.Source = timeoutctx.SourceOverride
+	}
+
+	fields := map[string]interface{}{
+		logging.KeyOperation: r.Operation,
+		logging.KeySource:    string(r.Source),
+		logging.KeyTimeout:   r.Timeout.String(),
+	}
+
+	if r.Raw != "" {
+		fields[logging.KeyValue] = r.Raw
+	}
+
+	logging.Debug(ctx, "resolved "+r.Operation+" timeout", fields)
+
+	return r, err
+}
+
+// defaultTimeout returns the default timeout of the named operation, which is
+// the supplied default timeout unless replaced by ProviderDefault, along with its
+// Source.
+func (c Core) defaultTimeout(ctx context.Context, name string, defaultTimeout time.Duration) (time.Duration, timeoutctx.Source) {
+	if c.ProviderDefault != nil {
+		return c.ProviderDefault(ctx, name, defaultTimeout)
+	}
+
+	explain.Record(ctx, "default %s", duration.String(defaultTimeout))
+
+	return defaultTimeout, timeoutctx.SourceDefault
+}
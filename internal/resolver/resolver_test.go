@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resolver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolver"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+func raw(s string) *string {
+	return &s
+}
+
+func TestCoreResolve(t *testing.T) {
+	t.Parallel()
+
+	core := resolver.Core{
+		Operations:        []string{"create", "read"},
+		DefaultAttribute:  "default",
+		DefaultOperations: []string{"create"},
+		AllowNone:         true,
+	}
+
+	type testCase struct {
+		attributes     resolver.Attributes
+		name           string
+		expectedResult resolver.Result
+		expectedErr    string
+	}
+	tests := map[string]testCase{
+		"not-operation": {
+			attributes: resolver.Attributes{"deadline": raw("2030-01-02T15:04:05Z")},
+			name:       "deadline",
+			expectedResult: resolver.Result{
+				Operation: "deadline",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceDefault,
+			},
+		},
+		"not-found": {
+			attributes: resolver.Attributes{},
+			name:       "create",
+			expectedResult: resolver.Result{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceDefault,
+				Attribute: "create",
+			},
+		},
+		"null": {
+			attributes: resolver.Attributes{"read": nil},
+			name:       "read",
+			expectedResult: resolver.Result{
+				Operation: "read",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceDefault,
+				Attribute: "read",
+			},
+		},
+		"configured": {
+			attributes: resolver.Attributes{"create": raw("2x"), "default": raw("1h")},
+			name:       "create",
+			expectedResult: resolver.Result{
+				Operation: "create",
+				Timeout:   40 * time.Minute,
+				Source:    timeoutctx.SourceConfig,
+				Raw:       "2x",
+				Attribute: "create",
+			},
+		},
+		"default-attribute": {
+			attributes: resolver.Attributes{"create": nil, "default": raw("1h")},
+			name:       "create",
+			expectedResult: resolver.Result{
+				Operation: "create",
+				Timeout:   time.Hour,
+				Source:    timeoutctx.SourceConfig,
+				Raw:       "1h",
+				Attribute: "default",
+			},
+		},
+		"default-attribute-not-applicable": {
+			attributes: resolver.Attributes{"read": nil, "default": raw("1h")},
+			name:       "read",
+			expectedResult: resolver.Result{
+				Operation: "read",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceDefault,
+				Attribute: "read",
+			},
+		},
+		"none": {
+			attributes: resolver.Attributes{"read": raw("none")},
+			name:       "read",
+			expectedResult: resolver.Result{
+				Operation: "read",
+				Timeout:   duration.None,
+				Source:    timeoutctx.SourceConfig,
+				Raw:       "none",
+				Attribute: "read",
+			},
+		},
+		"invalid": {
+			attributes: resolver.Attributes{"read": raw("10y")},
+			name:       "read",
+			expectedResult: resolver.Result{
+				Operation: "read",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceDefault,
+				Raw:       "10y",
+				Attribute: "read",
+			},
+			expectedErr: `timeout for "read" cannot be parsed, time: unknown unit "y" in duration "10y"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult, gotErr := core.Resolve(context.Background(), test.attributes, test.name, 20*time.Minute)
+
+			if diff := cmp.Diff(gotResult, test.expectedResult); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			var gotErrString string
+			if gotErr != nil {
+				gotErrString = gotErr.Error()
+			}
+
+			if diff := cmp.Diff(gotErrString, test.expectedErr); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCoreResolveUnconfigured(t *testing.T) {
+	t.Parallel()
+
+	core := resolver.Core{
+		Operations: []string{"create"},
+		ProviderDefault: func(_ context.Context, _ string, _ time.Duration) (time.Duration, timeoutctx.Source) {
+			return time.Hour, timeoutctx.SourceProviderDefault
+		},
+	}
+
+	got, err := core.ResolveUnconfigured(context.Background(), "import", 20*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := resolver.Result{
+		Operation: "import",
+		Timeout:   time.Hour,
+		Source:    timeoutctx.SourceProviderDefault,
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected result difference: %s", diff)
+	}
+}
+
+//nolint:paralleltest // t.Setenv cannot be used in parallel tests
+func TestCoreResolveOverride(t *testing.T) {
+	t.Setenv("TF_TIMEOUTS_CREATE", "45m")
+
+	got, err := resolver.Core{Operations: []string{"create"}}.Resolve(context.Background(), resolver.Attributes{"create": raw("1h")}, "create", 20*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Timeout != 45*time.Minute {
+		t.Errorf("expected timeout %s, got %s", 45*time.Minute, got.Timeout)
+	}
+
+	if got.Source != timeoutctx.SourceOverride {
+		t.Errorf("expected source %q, got %q", timeoutctx.SourceOverride, got.Source)
+	}
+}
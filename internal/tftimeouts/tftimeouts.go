@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tftimeouts resolves timeouts from terraform-plugin-go tftypes values,
+// for use without the framework, with the same resolver.Core as the Value
+// accessors.
+package tftimeouts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolver"
+)
+
+const (
+	// AttributeName is the name of the timeouts attribute or block at the root
+	// of a schema.
+	AttributeName = "timeouts"
+
+	// AttributeNameDeadline is the name of the attribute within the timeouts
+	// object holding an absolute RFC3339 deadline.
+	AttributeNameDeadline = "deadline"
+)
+
+// FromObject returns the value of the timeouts attribute or block within the
+// supplied object, such as a resource configuration, plan or state. A null value
+// is returned if the object is null, unknown or not an object, or if it does not
+// contain a timeouts attribute or block.
+func FromObject(object tftypes.Value) tftypes.Value {
+	null := tftypes.NewValue(tftypes.Object{}, nil)
+
+	if object.Type() == nil || !object.Type().Is(tftypes.Object{}) || !object.IsKnown() || object.IsNull() {
+		return null
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := object.As(&attributes); err != nil {
+		return null
+	}
+
+	value, ok := attributes[AttributeName]
+	if !ok {
+		return null
+	}
+
+	return value
+}
+
+// Core resolves the timeouts of a timeouts object following the rules of a
+// framework package, such as the "default" attribute and "none" of resource
+// timeouts.
+type Core = resolver.Core
+
+// Resolve returns the Result of the timeout for the named operation from the
+// supplied timeouts object, resolved by core as for the Value accessors of the
+// framework packages. Attributes of the object which are not strings are ignored.
+// If any errors are generated they are joined and returned, along with the
+// Result returned by core.
+func Resolve(ctx context.Context, core Core, timeouts tftypes.Value, name string, defaultTimeout time.Duration) (resolver.Result, error) {
+	attributes, err := attributes(timeouts)
+	if err != nil {
+		err = fmt.Errorf("timeout for %q cannot be read, %w", name, err)
+	}
+
+	r, resolveErr := core.Resolve(ctx, attributes, name, defaultTimeout)
+
+	return r, errors.Join(err, resolveErr)
+}
+
+// ResolveUnconfigured returns the Result of the timeout for the named operation
+// which cannot be configured, such as "import", resolved by core. If any errors
+// are generated they are joined and returned, along with the Result returned by
+// core.
+func ResolveUnconfigured(ctx context.Context, core Core, name string, defaultTimeout time.Duration) (resolver.Result, error) {
+	return core.ResolveUnconfigured(ctx, name, defaultTimeout)
+}
+
+// attributes returns the string attributes of the supplied timeouts object as
+// resolver.Attributes, in which null and unknown values are nil. No attributes
+// are returned if timeouts is null or unknown.
+func attributes(timeouts tftypes.Value) (resolver.Attributes, error) {
+	if timeouts.Type() == nil || !timeouts.IsKnown() || timeouts.IsNull() {
+		return nil, nil
+	}
+
+	var values map[string]tftypes.Value
+
+	if err := timeouts.As(&values); err != nil {
+		return nil, err
+	}
+
+	attributes := make(resolver.Attributes, len(values))
+
+	for name, value := range values {
+		if !value.Type().Is(tftypes.String) {
+			continue
+		}
+
+		attributes[name] = nil
+
+		if !value.IsKnown() || value.IsNull() {
+			continue
+		}
+
+		var s string
+
+		if err := value.As(&s); err != nil {
+			return nil, err
+		}
+
+		attributes[name] = &s
+	}
+
+	return attributes, nil
+}
+
+// Deadline returns the absolute deadline from the supplied timeouts object, and
+// whether it is set. An error is returned if the deadline cannot be parsed.
+func Deadline(timeouts tftypes.Value) (time.Time, bool, error) {
+	raw, ok, err := stringAttribute(timeouts, AttributeNameDeadline)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("deadline cannot be read, %w", err)
+	}
+
+	if !ok {
+		return time.Time{}, false, nil
+	}
+
+	deadline, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("deadline cannot be parsed, %w", err)
+	}
+
+	return deadline, true, nil
+}
+
+// stringAttribute returns the named string attribute of the supplied object, and
+// whether it is set. The attribute is not set if the object is null or unknown,
+// or if the attribute is not defined, null or unknown.
+func stringAttribute(object tftypes.Value, name string) (string, bool, error) {
+	if object.Type() == nil || !object.IsKnown() || object.IsNull() {
+		return "", false, nil
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := object.As(&attributes); err != nil {
+		return "", false, err
+	}
+
+	value, ok := attributes[name]
+	if !ok || !value.IsKnown() || value.IsNull() {
+		return "", false, nil
+	}
+
+	var s string
+
+	if err := value.As(&s); err != nil {
+		return "", false, err
+	}
+
+	return s, true, nil
+}
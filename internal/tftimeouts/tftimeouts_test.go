@@ -0,0 +1,324 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tftimeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolver"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/tftimeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+var timeoutsType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"create":   tftypes.String,
+		"deadline": tftypes.String,
+	},
+}
+
+func timeoutsValue(create, deadline interface{}) tftypes.Value {
+	return tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+		"create":   tftypes.NewValue(tftypes.String, create),
+		"deadline": tftypes.NewValue(tftypes.String, deadline),
+	})
+}
+
+func TestFromObject(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":     tftypes.String,
+			"timeouts": timeoutsType,
+		},
+	}
+
+	type testCase struct {
+		object   tftypes.Value
+		expected tftypes.Value
+	}
+	tests := map[string]testCase{
+		"zero": {
+			object:   tftypes.Value{},
+			expected: tftypes.NewValue(tftypes.Object{}, nil),
+		},
+		"null": {
+			object:   tftypes.NewValue(objectType, nil),
+			expected: tftypes.NewValue(tftypes.Object{}, nil),
+		},
+		"unknown": {
+			object:   tftypes.NewValue(objectType, tftypes.UnknownValue),
+			expected: tftypes.NewValue(tftypes.Object{}, nil),
+		},
+		"not-object": {
+			object:   tftypes.NewValue(tftypes.String, "example"),
+			expected: tftypes.NewValue(tftypes.Object{}, nil),
+		},
+		"without-timeouts": {
+			object: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "example"),
+				},
+			),
+			expected: tftypes.NewValue(tftypes.Object{}, nil),
+		},
+		"with-timeouts": {
+			object: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"name":     tftypes.NewValue(tftypes.String, "example"),
+				"timeouts": timeoutsValue("10m", nil),
+			}),
+			expected: timeoutsValue("10m", nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tftimeouts.FromObject(test.object)
+
+			if got.IsNull() != test.expected.IsNull() || (!got.IsNull() && !got.Equal(test.expected)) {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	core := tftimeouts.Core{
+		Operations: []string{"create", "read"},
+		AllowNone:  true,
+	}
+
+	type testCase struct {
+		timeouts           tftypes.Value
+		expectedResolution resolver.Result
+		expectedErr        string
+	}
+	tests := map[string]testCase{
+		"null": {
+			timeouts: tftypes.NewValue(timeoutsType, nil),
+			expectedResolution: resolver.Result{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceDefault,
+				Attribute: "create",
+			},
+		},
+		"unknown": {
+			timeouts: tftypes.NewValue(timeoutsType, tftypes.UnknownValue),
+			expectedResolution: resolver.Result{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceDefault,
+				Attribute: "create",
+			},
+		},
+		"attribute-null": {
+			timeouts: timeoutsValue(nil, nil),
+			expectedResolution: resolver.Result{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceDefault,
+				Attribute: "create",
+			},
+		},
+		"attribute-unknown": {
+			timeouts: timeoutsValue(tftypes.UnknownValue, nil),
+			expectedResolution: resolver.Result{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceDefault,
+				Attribute: "create",
+			},
+		},
+		"absolute": {
+			timeouts: timeoutsValue("1h", nil),
+			expectedResolution: resolver.Result{
+				Operation: "create",
+				Timeout:   time.Hour,
+				Source:    timeoutctx.SourceConfig,
+				Raw:       "1h",
+				Attribute: "create",
+			},
+		},
+		"relative": {
+			timeouts: timeoutsValue("2x", nil),
+			expectedResolution: resolver.Result{
+				Operation: "create",
+				Timeout:   40 * time.Minute,
+				Source:    timeoutctx.SourceConfig,
+				Raw:       "2x",
+				Attribute: "create",
+			},
+		},
+		"none": {
+			timeouts: timeoutsValue("none", nil),
+			expectedResolution: resolver.Result{
+				Operation: "create",
+				Timeout:   duration.None,
+				Source:    timeoutctx.SourceConfig,
+				Raw:       "none",
+				Attribute: "create",
+			},
+		},
+		"invalid": {
+			timeouts: timeoutsValue("10y", nil),
+			expectedResolution: resolver.Result{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceDefault,
+				Raw:       "10y",
+				Attribute: "create",
+			},
+			expectedErr: `timeout for "create" cannot be parsed, time: unknown unit "y" in duration "10y"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResolution, gotErr := tftimeouts.Resolve(context.Background(), core, test.timeouts, "create", 20*time.Minute)
+
+			if diff := cmp.Diff(gotResolution, test.expectedResolution); diff != "" {
+				t.Errorf("unexpected resolution difference: %s", diff)
+			}
+
+			var gotErrString string
+			if gotErr != nil {
+				gotErrString = gotErr.Error()
+			}
+
+			if diff := cmp.Diff(gotErrString, test.expectedErr); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveDefaultAttribute(t *testing.T) {
+	t.Parallel()
+
+	core := tftimeouts.Core{
+		Operations:        []string{"create"},
+		DefaultAttribute:  "default",
+		DefaultOperations: []string{"create"},
+	}
+
+	timeouts := tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"create":  tftypes.String,
+				"default": tftypes.String,
+			},
+		},
+		map[string]tftypes.Value{
+			"create":  tftypes.NewValue(tftypes.String, nil),
+			"default": tftypes.NewValue(tftypes.String, "1h"),
+		},
+	)
+
+	got, err := tftimeouts.Resolve(context.Background(), core, timeouts, "create", 20*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := resolver.Result{
+		Operation: "create",
+		Timeout:   time.Hour,
+		Source:    timeoutctx.SourceConfig,
+		Raw:       "1h",
+		Attribute: "default",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected resolution difference: %s", diff)
+	}
+}
+
+//nolint:paralleltest // t.Setenv cannot be used in parallel tests
+func TestResolveOverride(t *testing.T) {
+	t.Setenv("TF_TIMEOUTS_CREATE", "45m")
+
+	got, err := tftimeouts.Resolve(context.Background(), tftimeouts.Core{Operations: []string{"create"}}, timeoutsValue("1h", nil), "create", 20*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Timeout != 45*time.Minute {
+		t.Errorf("expected timeout %s, got %s", 45*time.Minute, got.Timeout)
+	}
+
+	if got.Source != timeoutctx.SourceOverride {
+		t.Errorf("expected source %q, got %q", timeoutctx.SourceOverride, got.Source)
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		timeouts         tftypes.Value
+		expectedDeadline time.Time
+		expectedOk       bool
+		expectedErr      string
+	}
+	tests := map[string]testCase{
+		"null": {
+			timeouts: tftypes.NewValue(timeoutsType, nil),
+		},
+		"not-set": {
+			timeouts: timeoutsValue(nil, nil),
+		},
+		"set": {
+			timeouts:         timeoutsValue(nil, "2030-01-02T15:04:05Z"),
+			expectedDeadline: time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC),
+			expectedOk:       true,
+		},
+		"invalid": {
+			timeouts:    timeoutsValue(nil, "tomorrow"),
+			expectedErr: `deadline cannot be parsed, parsing time "tomorrow" as "2006-01-02T15:04:05Z07:00": cannot parse "tomorrow" as "2006"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotDeadline, gotOk, gotErr := tftimeouts.Deadline(test.timeouts)
+
+			if !gotDeadline.Equal(test.expectedDeadline) {
+				t.Errorf("expected deadline %s, got %s", test.expectedDeadline, gotDeadline)
+			}
+
+			if gotOk != test.expectedOk {
+				t.Errorf("expected ok %t, got %t", test.expectedOk, gotOk)
+			}
+
+			var gotErrString string
+			if gotErr != nil {
+				gotErrString = gotErr.Error()
+			}
+
+			if diff := cmp.Diff(gotErrString, test.expectedErr); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
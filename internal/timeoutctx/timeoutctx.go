@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeoutctx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+)
+
+// Source describes where a timeout was resolved from.
+type Source string
+
+const (
+	// SourceConfig indicates the timeout was set in the timeouts configuration.
+	SourceConfig Source = "config"
+
+	// SourceDefault indicates the timeout was not configured, so the default
+	// supplied to the accessor was used.
+	SourceDefault Source = "default"
+
+	// SourceProviderDefault indicates the timeout was not configured, so the
+	// default set by the provider with ContextWithDefaults was used.
+	SourceProviderDefault Source = "provider_default"
+
+	// SourceModuleDefault indicates the timeout was not configured, so the
+	// default set by the module in provider_meta was used.
+	SourceModuleDefault Source = "module_default"
+
+	// SourceOverride indicates the timeout was forced by an environment variable.
+	SourceOverride Source = "override"
+
+	// SourceDeadline indicates the operation was limited by the absolute
+	// "deadline" attribute rather than by its timeout.
+	SourceDeadline Source = "deadline"
+)
+
+// TimeoutError is the cause, returned by context.Cause, of the cancellation of
+// a context returned by WithTimeout or WithDeadline. It wraps
+// context.DeadlineExceeded, so errors.Is(err, context.DeadlineExceeded) is true.
+type TimeoutError struct {
+	// Operation is the name of the timed out operation, such as "create".
+	Operation string
+
+	// Timeout is the configured duration of the operation.
+	Timeout time.Duration
+
+	// Source is where the timeout was resolved from.
+	Source Source
+
+	// Deadline is the time at which the context was cancelled.
+	Deadline time.Time
+
+	// Elapsed is the time between the creation of the context and Deadline,
+	// which is when the context is cancelled with this error as its cause.
+	Elapsed time.Duration
+}
+
+// Error returns a message naming the operation, its timeout and where the
+// timeout was resolved from.
+func (e *TimeoutError) Error() string {
+	if e.Source == SourceDeadline {
+		return fmt.Sprintf("%s deadline of %s exceeded after %s: %s", e.Operation, e.Deadline.Format(time.RFC3339), e.Elapsed, context.DeadlineExceeded)
+	}
+
+	return fmt.Sprintf("%s timeout of %s (%s) exceeded after %s: %s", e.Operation, e.Timeout, e.Source, e.Elapsed, context.DeadlineExceeded)
+}
+
+// Unwrap returns context.DeadlineExceeded.
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// WithTimeout returns a copy of ctx which is cancelled after the timeout for the
+// named operation, along with its cancel function. The cause of the cancellation,
+// returned by context.Cause, is a *TimeoutError. No deadline is applied if timeout
+// is duration.None.
+func WithTimeout(ctx context.Context, operation string, timeout time.Duration, source Source) (context.Context, context.CancelFunc) {
+	if timeout == duration.None {
+		return context.WithCancel(ctx)
+	}
+
+	start := time.Now()
+
+	return context.WithDeadlineCause(ctx, start.Add(timeout), &TimeoutError{
+		Operation: operation,
+		Timeout:   timeout,
+		Source:    source,
+		Deadline:  start.Add(timeout),
+		Elapsed:   timeout,
+	})
+}
+
+// WithDeadline returns a copy of ctx which is cancelled at the deadline for the
+// named operation, along with its cancel function. The cause of the cancellation,
+// returned by context.Cause, is a *TimeoutError with SourceDeadline and the
+// supplied timeout, which was superseded by the deadline.
+func WithDeadline(ctx context.Context, operation string, deadline time.Time, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithDeadlineCause(ctx, deadline, &TimeoutError{
+		Operation: operation,
+		Timeout:   timeout,
+		Source:    SourceDeadline,
+		Deadline:  deadline,
+		Elapsed:   max(time.Until(deadline), 0),
+	})
+}
+
+// FromError returns the *TimeoutError describing err, and whether one was found.
+// The error is first searched with errors.As. If err is context.DeadlineExceeded,
+// or wraps it, without a *TimeoutError, the cause of ctx, returned by
+// context.Cause, is searched instead, as errors returned by most clients do not
+// include the cause.
+func FromError(ctx context.Context, err error) (*TimeoutError, bool) {
+	var timeoutErr *TimeoutError
+
+	if !errors.As(err, &timeoutErr) {
+		if !errors.Is(err, context.DeadlineExceeded) {
+			return nil, false
+		}
+
+		if !errors.As(context.Cause(ctx), &timeoutErr) {
+			return nil, false
+		}
+	}
+
+	return timeoutErr, true
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeoutctx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := timeoutctx.WithTimeout(context.Background(), "create", 10*time.Millisecond, timeoutctx.SourceConfig)
+	defer cancel()
+
+	<-ctx.Done()
+
+	// Time elapsing after the deadline is not included in Elapsed.
+	time.Sleep(10 * time.Millisecond)
+
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", ctx.Err())
+	}
+
+	cause := context.Cause(ctx)
+
+	if !errors.Is(cause, context.DeadlineExceeded) {
+		t.Errorf("expected cause to wrap context.DeadlineExceeded, got %v", cause)
+	}
+
+	timeoutErr, ok := timeoutctx.FromError(ctx, ctx.Err())
+
+	if !ok {
+		t.Fatalf("expected cause to be *TimeoutError, got %T", cause)
+	}
+
+	expected := &timeoutctx.TimeoutError{
+		Operation: "create",
+		Timeout:   10 * time.Millisecond,
+		Source:    timeoutctx.SourceConfig,
+		Elapsed:   10 * time.Millisecond,
+	}
+
+	if diff := cmp.Diff(timeoutErr, expected, cmpopts.IgnoreFields(timeoutctx.TimeoutError{}, "Deadline")); diff != "" {
+		t.Errorf("unexpected error difference: %s", diff)
+	}
+
+	if got, expected := cause.Error(), "create timeout of 10ms (config) exceeded after 10ms: context deadline exceeded"; got != expected {
+		t.Errorf("expected cause %q, got %q", expected, got)
+	}
+	// The cause returned by context.Cause carries Elapsed without FromError.
+	var causeErr *timeoutctx.TimeoutError
+
+	if !errors.As(cause, &causeErr) {
+		t.Fatalf("expected cause to be *TimeoutError, got %T", cause)
+	}
+
+	if causeErr.Elapsed != 10*time.Millisecond {
+		t.Errorf("expected cause elapsed of 10ms, got %s", causeErr.Elapsed)
+	}
+}
+
+func TestWithTimeoutNone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := timeoutctx.WithTimeout(context.Background(), "create", duration.None, timeoutctx.SourceConfig)
+	defer cancel()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		t.Errorf("expected no deadline, got %s", deadline)
+	}
+}
+
+func TestWithDeadline(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	ctx, cancel := timeoutctx.WithDeadline(context.Background(), "update", deadline, time.Hour)
+	defer cancel()
+
+	<-ctx.Done()
+
+	cause := context.Cause(ctx)
+
+	if !errors.Is(cause, context.DeadlineExceeded) {
+		t.Errorf("expected cause to wrap context.DeadlineExceeded, got %v", cause)
+	}
+
+	expected := &timeoutctx.TimeoutError{
+		Operation: "update",
+		Timeout:   time.Hour,
+		Source:    timeoutctx.SourceDeadline,
+		Deadline:  deadline,
+	}
+
+	timeoutErr, ok := timeoutctx.FromError(ctx, ctx.Err())
+
+	if !ok {
+		t.Fatalf("expected cause to be *TimeoutError, got %T", cause)
+	}
+
+	if diff := cmp.Diff(timeoutErr, expected); diff != "" {
+		t.Errorf("unexpected error difference: %s", diff)
+	}
+
+	if got, expected := cause.Error(), "update deadline of 2006-01-02T15:04:05Z exceeded after 0s: context deadline exceeded"; got != expected {
+		t.Errorf("expected cause %q, got %q", expected, got)
+	}
+}
+
+func TestFromError(t *testing.T) {
+	t.Parallel()
+
+	timeoutErr := &timeoutctx.TimeoutError{
+		Operation: "create",
+		Timeout:   time.Nanosecond,
+		Source:    timeoutctx.SourceConfig,
+		Elapsed:   time.Nanosecond,
+	}
+
+	ctx, cancel := context.WithDeadlineCause(context.Background(), time.Now(), timeoutErr)
+	defer cancel()
+
+	<-ctx.Done()
+
+	type testCase struct {
+		ctx      context.Context
+		err      error
+		expected *timeoutctx.TimeoutError
+	}
+	tests := map[string]testCase{
+		"nil": {
+			ctx: ctx,
+		},
+		"other-error": {
+			ctx: ctx,
+			err: errors.New("other"),
+		},
+		"timeout-error": {
+			ctx:      context.Background(),
+			err:      fmt.Errorf("creating: %w", timeoutErr),
+			expected: timeoutErr,
+		},
+		"deadline-exceeded-cause": {
+			ctx:      ctx,
+			err:      fmt.Errorf("creating: %w", context.DeadlineExceeded),
+			expected: timeoutErr,
+		},
+		"deadline-exceeded-no-cause": {
+			ctx: context.Background(),
+			err: context.DeadlineExceeded,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := timeoutctx.FromError(test.ctx, test.err)
+
+			if ok != (test.expected != nil) {
+				t.Errorf("expected ok %t, got %t", test.expected != nil, ok)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeoutdiag
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/env"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+const (
+	summary = "Operation Timed Out"
+
+	attributeNameDeadline = "deadline"
+
+	operationNameImport = "import"
+)
+
+// Syntax is the configuration syntax of the timeouts, which determines the form
+// of the snippet included in a diagnostic.
+type Syntax int
+
+const (
+	// SyntaxUnknown indicates the timeouts may be either a block or an attribute,
+	// so the snippet shows both forms.
+	SyntaxUnknown Syntax = iota
+
+	// SyntaxBlock indicates the timeouts are a block, such as timeouts { ... }.
+	SyntaxBlock
+
+	// SyntaxAttribute indicates the timeouts are an attribute, such as
+	// timeouts = { ... }.
+	SyntaxAttribute
+)
+
+// Diagnostic returns an error diagnostic describing timeoutErr. The detail names
+// the operation, its timeout, where the timeout was resolved from and the elapsed
+// time, along with a configuration snippet in the given syntax showing how to
+// allow more time. Unless the timeout was forced by an environment variable or
+// is the import timeout, which cannot be configured, the diagnostic is associated
+// with the attribute for the operation within timeoutsPath.
+func Diagnostic(timeoutErr *timeoutctx.TimeoutError, timeoutsPath path.Path, syntax Syntax) diag.Diagnostic {
+	operation := timeoutErr.Operation
+
+	switch {
+	case timeoutErr.Source == timeoutctx.SourceOverride:
+		return diag.NewErrorDiagnostic(
+			summary,
+			fmt.Sprintf("The %s operation did not complete within its timeout of %s, after %s. "+
+				"The timeout was set by the %s environment variable, which must be raised or unset "+
+				"to allow more time.", operation, format(timeoutErr.Timeout), formatElapsed(timeoutErr.Elapsed), env.Operation(operation)),
+		)
+	case timeoutErr.Source == timeoutctx.SourceDeadline:
+		return diag.NewAttributeErrorDiagnostic(
+			timeoutsPath.AtName(attributeNameDeadline),
+			summary,
+			fmt.Sprintf("The %s operation did not complete before the deadline of %s, after %s. "+
+				"If the operation is expected to take longer, set a later deadline, %s",
+				operation, timeoutErr.Deadline.Format(time.RFC3339), formatElapsed(timeoutErr.Elapsed),
+				snippet(timeoutsPath, syntax, attributeNameDeadline, timeoutErr.Deadline.Add(time.Hour).Format(time.RFC3339))),
+		)
+	case operation == operationNameImport:
+		hint := fmt.Sprintf("set the %s environment variable", env.Operation(operation))
+
+		if timeoutErr.Source == timeoutctx.SourceModuleDefault {
+			hint = fmt.Sprintf("raise the %q timeout in the default_timeouts of the provider_meta block, or %s", operation, hint)
+		}
+
+		return diag.NewErrorDiagnostic(
+			summary,
+			fmt.Sprintf("The %s operation did not complete within its timeout of %s, %s, after %s. "+
+				"The import timeout cannot be configured in the resource, so to allow more time %s.",
+				operation, format(timeoutErr.Timeout), origin(timeoutErr.Source), formatElapsed(timeoutErr.Elapsed), hint),
+		)
+	}
+
+	return diag.NewAttributeErrorDiagnostic(
+		timeoutsPath.AtName(operation),
+		summary,
+		fmt.Sprintf("The %s operation did not complete within its timeout of %s, %s, after %s. "+
+			"If the operation is expected to take longer, raise the timeout, %s",
+			operation, format(timeoutErr.Timeout), origin(timeoutErr.Source), formatElapsed(timeoutErr.Elapsed),
+			snippet(timeoutsPath, syntax, operation, format(2*timeoutErr.Timeout))),
+	)
+}
+
+// origin returns a description of where a timeout with the given source was
+// resolved from, other than an environment variable or deadline.
+func origin(source timeoutctx.Source) string {
+	switch source {
+	case timeoutctx.SourceDefault:
+		return "the default for the operation"
+	case timeoutctx.SourceProviderDefault:
+		return "the default set by the provider"
+	case timeoutctx.SourceModuleDefault:
+		return "the default set by the module in provider_meta"
+	}
+
+	return "set in the configuration"
+}
+
+// snippet returns an example HCL snippet in the given syntax setting the named
+// attribute within the timeouts, named by the last step of timeoutsPath. If the
+// syntax is unknown, a snippet is returned for both a block and an attribute.
+func snippet(timeoutsPath path.Path, syntax Syntax, name string, value string) string {
+	timeouts := "timeouts"
+
+	step, _ := timeoutsPath.Steps().LastStep()
+
+	if attributeName, ok := step.(path.PathStepAttributeName); ok {
+		timeouts = string(attributeName)
+	}
+
+	block := fmt.Sprintf("  %s {\n    %s = %q\n  }", timeouts, name, value)
+	attribute := fmt.Sprintf("  %s = {\n    %s = %q\n  }", timeouts, name, value)
+
+	switch syntax {
+	case SyntaxBlock:
+		return "for example:\n\n" + block
+	case SyntaxAttribute:
+		return "for example:\n\n" + attribute
+	}
+
+	return fmt.Sprintf("for example, if %s is a block:\n\n%s\n\nor if it is an attribute:\n\n%s", timeouts, block, attribute)
+}
+
+// format returns d as a duration string without trailing zero units, such as "40m"
+// rather than "40m0s".
+func format(d time.Duration) string {
+	s := d.String()
+
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+
+	return s
+}
+
+// formatElapsed returns the measured elapsed time d as formatted by format,
+// rounded to the second if it is at least a second.
+func formatElapsed(d time.Duration) string {
+	if d >= time.Second {
+		d = d.Round(time.Second)
+	}
+
+	return format(d)
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeoutdiag_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutdiag"
+)
+
+func TestDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		timeoutErr   *timeoutctx.TimeoutError
+		timeoutsPath path.Path
+		syntax       timeoutdiag.Syntax
+		expected     diag.Diagnostic
+	}
+	tests := map[string]testCase{
+		"config": {
+			timeoutErr: &timeoutctx.TimeoutError{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceConfig,
+				Elapsed:   20 * time.Minute,
+			},
+			timeoutsPath: path.Root("timeouts"),
+			syntax:       timeoutdiag.SyntaxBlock,
+			expected: diag.NewAttributeErrorDiagnostic(
+				path.Root("timeouts").AtName("create"),
+				"Operation Timed Out",
+				"The create operation did not complete within its timeout of 20m, set in the configuration, after 20m. "+
+					"If the operation is expected to take longer, raise the timeout, for example:\n\n"+
+					"  timeouts {\n    create = \"40m\"\n  }",
+			),
+		},
+		"default": {
+			timeoutErr: &timeoutctx.TimeoutError{
+				Operation: "read",
+				Timeout:   time.Hour,
+				Source:    timeoutctx.SourceDefault,
+				Elapsed:   time.Hour,
+			},
+			timeoutsPath: path.Root("settings").AtName("operation_timeouts"),
+			syntax:       timeoutdiag.SyntaxBlock,
+			expected: diag.NewAttributeErrorDiagnostic(
+				path.Root("settings").AtName("operation_timeouts").AtName("read"),
+				"Operation Timed Out",
+				"The read operation did not complete within its timeout of 1h, the default for the operation, after 1h. "+
+					"If the operation is expected to take longer, raise the timeout, for example:\n\n"+
+					"  operation_timeouts {\n    read = \"2h\"\n  }",
+			),
+		},
+		"provider-default": {
+			timeoutErr: &timeoutctx.TimeoutError{
+				Operation: "create",
+				Timeout:   30 * time.Minute,
+				Source:    timeoutctx.SourceProviderDefault,
+				Elapsed:   30*time.Minute + 1500*time.Millisecond,
+			},
+			timeoutsPath: path.Root("timeouts"),
+			syntax:       timeoutdiag.SyntaxBlock,
+			expected: diag.NewAttributeErrorDiagnostic(
+				path.Root("timeouts").AtName("create"),
+				"Operation Timed Out",
+				"The create operation did not complete within its timeout of 30m, the default set by the provider, after 30m2s. "+
+					"If the operation is expected to take longer, raise the timeout, for example:\n\n"+
+					"  timeouts {\n    create = \"1h\"\n  }",
+			),
+		},
+		"module-default": {
+			timeoutErr: &timeoutctx.TimeoutError{
+				Operation: "delete",
+				Timeout:   time.Hour,
+				Source:    timeoutctx.SourceModuleDefault,
+				Elapsed:   time.Hour,
+			},
+			timeoutsPath: path.Root("timeouts"),
+			syntax:       timeoutdiag.SyntaxBlock,
+			expected: diag.NewAttributeErrorDiagnostic(
+				path.Root("timeouts").AtName("delete"),
+				"Operation Timed Out",
+				"The delete operation did not complete within its timeout of 1h, the default set by the module in provider_meta, after 1h. "+
+					"If the operation is expected to take longer, raise the timeout, for example:\n\n"+
+					"  timeouts {\n    delete = \"2h\"\n  }",
+			),
+		},
+		"attribute": {
+			timeoutErr: &timeoutctx.TimeoutError{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceConfig,
+				Elapsed:   20 * time.Minute,
+			},
+			timeoutsPath: path.Root("timeouts"),
+			syntax:       timeoutdiag.SyntaxAttribute,
+			expected: diag.NewAttributeErrorDiagnostic(
+				path.Root("timeouts").AtName("create"),
+				"Operation Timed Out",
+				"The create operation did not complete within its timeout of 20m, set in the configuration, after 20m. "+
+					"If the operation is expected to take longer, raise the timeout, for example:\n\n"+
+					"  timeouts = {\n    create = \"40m\"\n  }",
+			),
+		},
+		"syntax-unknown": {
+			timeoutErr: &timeoutctx.TimeoutError{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeoutctx.SourceConfig,
+				Elapsed:   20 * time.Minute,
+			},
+			timeoutsPath: path.Root("timeouts"),
+			expected: diag.NewAttributeErrorDiagnostic(
+				path.Root("timeouts").AtName("create"),
+				"Operation Timed Out",
+				"The create operation did not complete within its timeout of 20m, set in the configuration, after 20m. "+
+					"If the operation is expected to take longer, raise the timeout, for example, if timeouts is a block:\n\n"+
+					"  timeouts {\n    create = \"40m\"\n  }\n\n"+
+					"or if it is an attribute:\n\n"+
+					"  timeouts = {\n    create = \"40m\"\n  }",
+			),
+		},
+		"import": {
+			timeoutErr: &timeoutctx.TimeoutError{
+				Operation: "import",
+				Timeout:   5 * time.Minute,
+				Source:    timeoutctx.SourceProviderDefault,
+				Elapsed:   5 * time.Minute,
+			},
+			timeoutsPath: path.Root("timeouts"),
+			syntax:       timeoutdiag.SyntaxBlock,
+			expected: diag.NewErrorDiagnostic(
+				"Operation Timed Out",
+				"The import operation did not complete within its timeout of 5m, the default set by the provider, after 5m. "+
+					"The import timeout cannot be configured in the resource, so to allow more time set the "+
+					"TF_TIMEOUTS_IMPORT environment variable.",
+			),
+		},
+		"import-module-default": {
+			timeoutErr: &timeoutctx.TimeoutError{
+				Operation: "import",
+				Timeout:   5 * time.Minute,
+				Source:    timeoutctx.SourceModuleDefault,
+				Elapsed:   5 * time.Minute,
+			},
+			timeoutsPath: path.Root("timeouts"),
+			syntax:       timeoutdiag.SyntaxBlock,
+			expected: diag.NewErrorDiagnostic(
+				"Operation Timed Out",
+				"The import operation did not complete within its timeout of 5m, the default set by the module in provider_meta, after 5m. "+
+					"The import timeout cannot be configured in the resource, so to allow more time raise the \"import\" "+
+					"timeout in the default_timeouts of the provider_meta block, or set the TF_TIMEOUTS_IMPORT environment variable.",
+			),
+		},
+		"override": {
+			timeoutErr: &timeoutctx.TimeoutError{
+				Operation: "delete",
+				Timeout:   90 * time.Second,
+				Source:    timeoutctx.SourceOverride,
+				Elapsed:   90 * time.Second,
+			},
+			timeoutsPath: path.Root("timeouts"),
+			expected: diag.NewErrorDiagnostic(
+				"Operation Timed Out",
+				"The delete operation did not complete within its timeout of 1m30s, after 1m30s. "+
+					"The timeout was set by the TF_TIMEOUTS_DELETE environment variable, which must be raised or unset "+
+					"to allow more time.",
+			),
+		},
+		"deadline": {
+			timeoutErr: &timeoutctx.TimeoutError{
+				Operation: "update",
+				Timeout:   time.Hour,
+				Source:    timeoutctx.SourceDeadline,
+				Deadline:  time.Date(2026, 11, 1, 6, 0, 0, 0, time.UTC),
+				Elapsed:   10 * time.Minute,
+			},
+			timeoutsPath: path.Root("timeouts"),
+			syntax:       timeoutdiag.SyntaxBlock,
+			expected: diag.NewAttributeErrorDiagnostic(
+				path.Root("timeouts").AtName("deadline"),
+				"Operation Timed Out",
+				"The update operation did not complete before the deadline of 2026-11-01T06:00:00Z, after 10m. "+
+					"If the operation is expected to take longer, set a later deadline, for example:\n\n"+
+					"  timeouts {\n    deadline = \"2026-11-01T07:00:00Z\"\n  }",
+			),
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timeoutdiag.Diagnostic(test.timeoutErr, test.timeoutsPath, test.syntax)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostic difference: %s", diff)
+			}
+		})
+	}
+}
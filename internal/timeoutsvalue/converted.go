@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeoutsvalue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable  = ConvertedStringType{}
+	_ basetypes.StringValuable = ConvertedString{}
+)
+
+// ConvertedStringType is the type of the attributes of a V returned by
+// Core.Convert, so that Core.Resolve can tell that their values were configured
+// for an operation of another Value rather than at a path within V.
+type ConvertedStringType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if o is a ConvertedStringType.
+func (t ConvertedStringType) Equal(o attr.Type) bool {
+	_, ok := o.(ConvertedStringType)
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t ConvertedStringType) String() string {
+	return "timeoutsvalue.ConvertedStringType"
+}
+
+// ValueFromString returns a ConvertedString of v.
+func (t ConvertedStringType) ValueFromString(_ context.Context, v basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ConvertedString{v}, nil
+}
+
+// ValueFromTerraform returns a ConvertedString of the tftypes.Value.
+func (t ConvertedStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	s, ok := value.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", value)
+	}
+
+	return ConvertedString{s}, nil
+}
+
+// ValueType returns the zero ConvertedString.
+func (t ConvertedStringType) ValueType(_ context.Context) attr.Value {
+	return ConvertedString{}
+}
+
+// ConvertedString is the value of an attribute of a V returned by Core.Convert.
+type ConvertedString struct {
+	basetypes.StringValue
+}
+
+// Equal returns true if o is a ConvertedString with the same string value.
+func (v ConvertedString) Equal(o attr.Value) bool {
+	other, ok := o.(ConvertedString)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns ConvertedStringType.
+func (v ConvertedString) Type(_ context.Context) attr.Type {
+	return ConvertedStringType{}
+}
@@ -0,0 +1,488 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timeoutsvalue implements the Type and Value of every timeouts package,
+// which are thin typed wrappers around a Core for their set of operations.
+package timeoutsvalue
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/explain"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolution"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolver"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+// attributeNameDeadline is the name of the attribute of a resource for an absolute
+// deadline, which is not the timeout of an operation.
+const attributeNameDeadline = "deadline"
+
+// Timeouts is implemented by the Value of every timeouts package, so that helpers
+// can accept the Value of any package.
+type Timeouts interface {
+	basetypes.ObjectValuable
+
+	// Operations returns the names of the operations whose timeouts can be
+	// configured, such as "create".
+	Operations() []string
+
+	// OperationTimeout returns the timeout for the named operation, as returned
+	// by the accessor for the operation. If any diagnostics are generated they are
+	// returned along with the supplied default timeout.
+	OperationTimeout(ctx context.Context, operation string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics)
+
+	// ResolveOperation returns the Resolution of the timeout returned by
+	// OperationTimeout. If any diagnostics are generated they are returned along
+	// with a Resolution of the supplied default timeout.
+	ResolveOperation(ctx context.Context, operation string, defaultTimeout time.Duration) (resolution.Resolution, diag.Diagnostics)
+}
+
+// ObjectType is satisfied by the Type of every timeouts package.
+type ObjectType interface {
+	~struct{ basetypes.ObjectType }
+	attr.Type
+}
+
+// ObjectValue is satisfied by the Value of every timeouts package.
+type ObjectValue interface {
+	~struct{ types.Object }
+	attr.Value
+}
+
+// Core implements the methods of the Type T and Value V of a timeouts package
+// for its set of operations. Timeouts are resolved by a resolver.Core with the
+// same fields, from the attributes of V converted to resolver.Attributes.
+type Core[T ObjectType, V ObjectValue] struct {
+	// Operations are the names of the operations whose timeouts can be
+	// configured, in the order they are explained.
+	Operations []string
+
+	// ProviderDefault, if set, returns the default timeout of an operation which
+	// has not been configured, in place of the default supplied to the accessor,
+	// along with the Source of the default. It is responsible for recording the
+	// default in any explanation.
+	ProviderDefault func(ctx context.Context, name string, defaultTimeout time.Duration) (time.Duration, timeoutctx.Source)
+
+	// DefaultAttribute, if set, is the name of an attribute whose value applies to
+	// each of DefaultOperations which has not been configured.
+	DefaultAttribute string
+
+	// DefaultOperations are the names of the operations to which DefaultAttribute
+	// applies.
+	DefaultOperations []string
+
+	// AllowNone indicates whether a timeout may be set to "none", for which
+	// duration.None is returned. Otherwise "none" cannot be parsed.
+	AllowNone bool
+}
+
+// ValueFromObject returns a V given a basetypes.ObjectValue.
+func (c Core[T, V]) ValueFromObject(in basetypes.ObjectValue) V {
+	return V{in}
+}
+
+// ValueFromTerraform returns a V given a tftypes.Value, embedding the types.Object
+// value returned from calling ValueFromTerraform on the types.ObjectType of t.
+func (c Core[T, V]) ValueFromTerraform(ctx context.Context, t T, in tftypes.Value) (attr.Value, error) {
+	val, err := struct{ basetypes.ObjectType }(t).ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := val.(types.Object)
+	if !ok {
+		return nil, fmt.Errorf("%T cannot be used as types.Object", val)
+	}
+
+	return V{obj}, err
+}
+
+// TypeEqual returns true if candidate is also a T and has the same AttributeTypes
+// as t.
+func (c Core[T, V]) TypeEqual(t T, candidate attr.Type) bool {
+	other, ok := candidate.(T)
+	if !ok {
+		return false
+	}
+
+	return struct{ basetypes.ObjectType }(t).Equal(struct{ basetypes.ObjectType }(other).ObjectType)
+}
+
+// ValueEqual returns true if candidate is also a V and has the same type and
+// value as v.
+func (c Core[T, V]) ValueEqual(v V, candidate attr.Value) bool {
+	other, ok := candidate.(V)
+	if !ok {
+		return false
+	}
+
+	return object(v).Equal(object(other))
+}
+
+// ValueType returns a T with the same attribute types as v.
+func (c Core[T, V]) ValueType(ctx context.Context, v V) attr.Type {
+	return T{
+		types.ObjectType{
+			AttrTypes: object(v).AttributeTypes(ctx),
+		},
+	}
+}
+
+// Timeout returns the timeout for the named operation of v. If any diagnostics
+// are generated they are returned along with the supplied default timeout.
+func (c Core[T, V]) Timeout(ctx context.Context, v V, name string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	r, diags := c.Resolve(ctx, v, name, defaultTimeout, path.Empty())
+
+	return r.Timeout, diags
+}
+
+// Resolve returns the timeout for the named operation of v along with where it
+// was resolved from. The Path of the Resolution is within timeoutsPath, which is
+// the path of v in the schema. If the operation is not one of Operations, the
+// supplied default timeout is returned unchanged, as the operation cannot be
+// configured in v.
+func (c Core[T, V]) Resolve(ctx context.Context, v V, name string, defaultTimeout time.Duration, timeoutsPath path.Path) (resolution.Resolution, diag.Diagnostics) {
+	obj := object(v)
+
+	attributes, diags := c.attributes(ctx, obj)
+
+	res, err := c.resolver().Resolve(ctx, attributes, name, defaultTimeout)
+	diags.Append(errorDiagnostics(err)...)
+
+	r := resolution.Resolution{
+		Operation: res.Operation,
+		Timeout:   res.Timeout,
+		Source:    res.Source,
+		Raw:       res.Raw,
+	}
+
+	// The values of a V returned by Convert were configured for an operation of
+	// another Value, so have no path within timeoutsPath.
+	if _, converted := obj.Attributes()[res.Attribute].(ConvertedString); converted {
+		r.Path = path.Empty()
+	} else if res.Attribute != "" {
+		r.Path = timeoutsPath.AtName(res.Attribute)
+	}
+
+	return r, diags
+}
+
+// ResolveUnconfigured returns the timeout for the named operation which cannot be
+// configured in a V, such as "import", along with where it was resolved from. The
+// supplied default timeout is replaced by any ProviderDefault, and environment
+// variable overrides are applied, as for Resolve. The Raw and Path fields of the
+// Resolution are always empty.
+func (c Core[T, V]) ResolveUnconfigured(ctx context.Context, name string, defaultTimeout time.Duration) (resolution.Resolution, diag.Diagnostics) {
+	res, err := c.resolver().ResolveUnconfigured(ctx, name, defaultTimeout)
+
+	return resolution.Resolution{
+		Operation: res.Operation,
+		Timeout:   res.Timeout,
+		Source:    res.Source,
+	}, errorDiagnostics(err)
+}
+
+// ValueAtPath returns the V of the attribute or block at timeoutsPath using the
+// GetAttribute method of a tfsdk.Plan, tfsdk.State or tfsdk.Config. Objects which
+// do not use T as their custom type are converted to a V.
+func (c Core[T, V]) ValueAtPath(ctx context.Context, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics, timeoutsPath path.Path) (V, diag.Diagnostics) {
+	var value attr.Value
+
+	diags := getAttribute(ctx, timeoutsPath, &value)
+
+	if diags.HasError() {
+		return V{}, diags
+	}
+
+	switch v := value.(type) {
+	case V:
+		return v, diags
+	case basetypes.ObjectValuable:
+		obj, d := v.ToObjectValue(ctx)
+		diags.Append(d...)
+
+		return V{obj}, diags
+	}
+
+	diags.AddAttributeError(
+		timeoutsPath,
+		"Timeouts Cannot Be Read",
+		fmt.Sprintf("timeouts must be an object, got %T", value),
+	)
+
+	return V{}, diags
+}
+
+// NewValue returns a known V with the attribute types attrTypes, in which the
+// attribute of each operation keyed by name in timeouts is set to the duration
+// formatted as a string, and all other attributes are null. An error diagnostic is
+// returned, along with an unknown V, if timeouts contains a key which is not an
+// operation of attrTypes, such as "deadline", or duration.None when AllowNone is
+// false.
+func (c Core[T, V]) NewValue(attrTypes map[string]attr.Type, timeouts map[string]time.Duration) (V, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrValues := make(map[string]attr.Value, len(attrTypes))
+
+	for name := range attrTypes {
+		attrValues[name] = types.StringNull()
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(timeouts)) {
+		_, ok := attrTypes[name]
+
+		switch {
+		case !slices.Contains(c.Operations, name):
+			diags.AddError(
+				"Invalid Timeouts Value",
+				fmt.Sprintf("timeout for %q cannot be set, it is not an operation, expected one of %q", name, c.Operations),
+			)
+
+			continue
+		case !ok:
+			diags.AddError(
+				"Invalid Timeouts Value",
+				fmt.Sprintf("timeout for %q cannot be set, the operation is not supported by the timeouts attributes", name),
+			)
+
+			continue
+		case timeouts[name] == duration.None && !c.AllowNone:
+			diags.AddError(
+				"Invalid Timeouts Value",
+				fmt.Sprintf("timeout for %q cannot be set, %q is not supported", name, duration.String(duration.None)),
+			)
+
+			continue
+		}
+
+		attrValues[name] = types.StringValue(duration.String(timeouts[name]))
+	}
+
+	if diags.HasError() {
+		return V{types.ObjectUnknown(attrTypes)}, diags
+	}
+
+	object, d := types.ObjectValue(attrTypes, attrValues)
+	diags.Append(d...)
+
+	return V{object}, diags
+}
+
+// Explain returns the Explanation of the timeout of each operation whose
+// attribute is present in the type of v, in the order of Operations. The
+// defaultTimeouts map, keyed by operation name, contains the default timeout
+// supplied for each operation, and the Path of each Resolution is within
+// timeoutsPath. Any diagnostics generated for the operations are returned
+// together.
+func (c Core[T, V]) Explain(ctx context.Context, v V, defaultTimeouts map[string]time.Duration, timeoutsPath path.Path) (resolution.Explanation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var e resolution.Explanation
+
+	attrTypes := object(v).AttributeTypes(ctx)
+
+	for _, name := range c.Operations {
+		if _, ok := attrTypes[name]; !ok {
+			continue
+		}
+
+		ctx := explain.ContextWithTrace(ctx)
+
+		r, d := c.Resolve(ctx, v, name, defaultTimeouts[name], timeoutsPath)
+		diags.Append(d...)
+
+		e.Operations = append(e.Operations, resolution.OperationExplanation{
+			Resolution: r,
+			Steps:      explain.Steps(ctx),
+		})
+	}
+
+	return e, diags
+}
+
+// resolver returns the resolver.Core applying the rules of c.
+func (c Core[T, V]) resolver() resolver.Core {
+	return resolver.Core{
+		Operations:        c.Operations,
+		ProviderDefault:   c.ProviderDefault,
+		DefaultAttribute:  c.DefaultAttribute,
+		DefaultOperations: c.DefaultOperations,
+		AllowNone:         c.AllowNone,
+	}
+}
+
+// attributes returns the string attributes of obj as resolver.Attributes, in
+// which null and unknown values are nil. Attributes which are not strings are
+// omitted, as the schema guarantees that the attributes are types.String, and
+// Convert that they are ConvertedString.
+func (c Core[T, V]) attributes(ctx context.Context, obj types.Object) (resolver.Attributes, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := make(resolver.Attributes, len(obj.Attributes()))
+
+	for name, value := range obj.Attributes() {
+		s, ok := value.(basetypes.StringValuable)
+		if !ok {
+			continue
+		}
+
+		str, d := s.ToStringValue(ctx)
+		diags.Append(d...)
+
+		attributes[name] = nil
+
+		if !str.IsNull() && !str.IsUnknown() {
+			raw := str.ValueString()
+			attributes[name] = &raw
+		}
+	}
+
+	return attributes, diags
+}
+
+// errorDiagnostics returns an error diagnostic for each of the errors joined in
+// err by the resolver.Core, each of which is a value which cannot be parsed.
+func errorDiagnostics(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+
+	var diags diag.Diagnostics
+
+	for _, err := range errs {
+		diags.AddError("Timeout Cannot Be Parsed", err.Error())
+	}
+
+	return diags
+}
+
+// object returns the types.Object embedded in v.
+func object[V ObjectValue](v V) types.Object {
+	return struct{ types.Object }(v).Object
+}
+
+// Convert returns a V whose operations take the configured values of the
+// operations of from. The operations map is keyed by the name of each operation
+// of V, with the name of the operation of from as the value. The configured
+// values are copied rather than resolved, so that relative timeouts are resolved
+// against the defaults supplied to the accessors of V. An operation of from which
+// is not configured takes the value of any attribute which applies to it instead,
+// such as the "default" attribute of an SDKv2 block. The attributes of V are
+// ConvertedString, whose Resolution has no Path, as V is not configured at a path.
+// An error diagnostic is returned if from sets a "deadline", as it is not the
+// timeout of an operation.
+func (c Core[T, V]) Convert(ctx context.Context, from Timeouts, operations map[string]string) (V, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	obj, d := from.ToObjectValue(ctx)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return V{}, diags
+	}
+
+	if deadline, ok := obj.Attributes()[attributeNameDeadline]; ok && !deadline.IsNull() {
+		diags.AddError(
+			"Timeouts Cannot Be Converted",
+			fmt.Sprintf("%q of %T cannot be converted, it is not the timeout of an operation", attributeNameDeadline, from),
+		)
+	}
+
+	attrTypes := make(map[string]attr.Type, len(operations))
+	attrValues := make(map[string]attr.Value, len(operations))
+
+	for _, to := range slices.Sorted(maps.Keys(operations)) {
+		name := operations[to]
+
+		if !slices.Contains(c.Operations, to) {
+			diags.AddError(
+				"Timeouts Cannot Be Converted",
+				fmt.Sprintf("operation %q is not supported, expected one of %q", to, c.Operations),
+			)
+
+			continue
+		}
+
+		if !slices.Contains(from.Operations(), name) {
+			diags.AddError(
+				"Timeouts Cannot Be Converted",
+				fmt.Sprintf("operation %q of %T is not supported, expected one of %q", name, from, from.Operations()),
+			)
+
+			continue
+		}
+
+		attrTypes[to] = ConvertedStringType{}
+		attrValues[to] = ConvertedString{types.StringNull()}
+
+		value, ok := obj.Attributes()[name]
+		if ok {
+			s, ok := value.(basetypes.StringValuable)
+			if !ok {
+				diags.AddError(
+					"Timeouts Cannot Be Converted",
+					fmt.Sprintf("timeout for %q must be a string, got %T", name, value),
+				)
+
+				continue
+			}
+
+			str, d := s.ToStringValue(ctx)
+			diags.Append(d...)
+
+			attrValues[to] = ConvertedString{str}
+
+			if !str.IsNull() {
+				continue
+			}
+		}
+
+		if obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+
+		// The Resolution only has a Raw value if one was configured, which for an
+		// operation that is not configured is that of an attribute which applies
+		// to it, such as "default". Any diagnostics for the value are returned by
+		// the accessors of V instead, as for a value which is copied.
+		r, _ := from.ResolveOperation(ctx, name, 0)
+
+		if r.Raw != "" {
+			attrValues[to] = ConvertedString{types.StringValue(r.Raw)}
+		}
+	}
+
+	if diags.HasError() {
+		return V{}, diags
+	}
+
+	switch {
+	case obj.IsNull():
+		return V{types.ObjectNull(attrTypes)}, diags
+	case obj.IsUnknown():
+		return V{types.ObjectUnknown(attrTypes)}, diags
+	}
+
+	converted, d := types.ObjectValue(attrTypes, attrValues)
+	diags.Append(d...)
+
+	return V{converted}, diags
+}
@@ -0,0 +1,756 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeoutsvalue_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolution"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutsvalue"
+	resourcetimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+type testType struct {
+	basetypes.ObjectType
+}
+
+type testValue struct {
+	types.Object
+}
+
+type otherValue struct {
+	types.Object
+}
+
+var testCore = timeoutsvalue.Core[testType, testValue]{
+	Operations:        []string{"create"},
+	DefaultAttribute:  "default",
+	DefaultOperations: []string{"create"},
+}
+
+func testObject(create, defaultTimeout attr.Value) types.Object {
+	return types.ObjectValueMust(
+		map[string]attr.Type{
+			"create":  types.StringType,
+			"default": types.StringType,
+		},
+		map[string]attr.Value{
+			"create":  create,
+			"default": defaultTimeout,
+		},
+	)
+}
+
+func TestCoreValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"create":  types.StringType,
+			"default": types.StringType,
+		},
+	}
+
+	got, err := testCore.ValueFromTerraform(context.Background(), testType{objectType}, tftypes.NewValue(
+		objectType.TerraformType(context.Background()),
+		map[string]tftypes.Value{
+			"create":  tftypes.NewValue(tftypes.String, "10m"),
+			"default": tftypes.NewValue(tftypes.String, nil),
+		},
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := testValue{testObject(types.StringValue("10m"), types.StringNull())}
+
+	if !testCore.ValueEqual(expected, got) {
+		t.Errorf("expected value %s, got %s", expected, got)
+	}
+
+	if gotType := testCore.ValueType(context.Background(), expected); !testCore.TypeEqual(testType{objectType}, gotType) {
+		t.Errorf("expected type %s, got %s", testType{objectType}, gotType)
+	}
+}
+
+func TestCoreEqual(t *testing.T) {
+	t.Parallel()
+
+	value := testValue{testObject(types.StringValue("10m"), types.StringNull())}
+
+	type testCase struct {
+		candidate attr.Value
+		expected  bool
+	}
+	tests := map[string]testCase{
+		"equal": {
+			candidate: testValue{testObject(types.StringValue("10m"), types.StringNull())},
+			expected:  true,
+		},
+		"different-value": {
+			candidate: testValue{testObject(types.StringValue("20m"), types.StringNull())},
+		},
+		"different-type": {
+			candidate: otherValue{testObject(types.StringValue("10m"), types.StringNull())},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCore.ValueEqual(value, test.candidate); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestCoreResolve(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		core           timeoutsvalue.Core[testType, testValue]
+		value          testValue
+		timeoutsPath   path.Path
+		expectedSource timeoutctx.Source
+		expectedPath   path.Path
+		expected       time.Duration
+	}
+	tests := map[string]testCase{
+		"configured": {
+			core:           testCore,
+			value:          testValue{testObject(types.StringValue("10m"), types.StringValue("30m"))},
+			expectedSource: timeoutctx.SourceConfig,
+			expectedPath:   path.Root("timeouts").AtName("create"),
+			expected:       10 * time.Minute,
+		},
+		"configured-at-path": {
+			core:           testCore,
+			value:          testValue{testObject(types.StringValue("10m"), types.StringValue("30m"))},
+			timeoutsPath:   path.Root("settings").AtName("operation_timeouts"),
+			expectedSource: timeoutctx.SourceConfig,
+			expectedPath:   path.Root("settings").AtName("operation_timeouts").AtName("create"),
+			expected:       10 * time.Minute,
+		},
+		"default-attribute": {
+			core:           testCore,
+			value:          testValue{testObject(types.StringNull(), types.StringValue("30m"))},
+			expectedSource: timeoutctx.SourceConfig,
+			expectedPath:   path.Root("timeouts").AtName("default"),
+			expected:       30 * time.Minute,
+		},
+		"default-attribute-not-used": {
+			core: timeoutsvalue.Core[testType, testValue]{
+				Operations: []string{"create"},
+			},
+			value:          testValue{testObject(types.StringNull(), types.StringValue("30m"))},
+			expectedSource: timeoutctx.SourceDefault,
+			expectedPath:   path.Root("timeouts").AtName("create"),
+			expected:       20 * time.Minute,
+		},
+		"default-attribute-not-default-operation": {
+			core: timeoutsvalue.Core[testType, testValue]{
+				Operations:        []string{"create", "read"},
+				DefaultAttribute:  "default",
+				DefaultOperations: []string{"read"},
+			},
+			value:          testValue{testObject(types.StringNull(), types.StringValue("30m"))},
+			expectedSource: timeoutctx.SourceDefault,
+			expectedPath:   path.Root("timeouts").AtName("create"),
+			expected:       20 * time.Minute,
+		},
+		"provider-default": {
+			core: timeoutsvalue.Core[testType, testValue]{
+				Operations: []string{"create"},
+				ProviderDefault: func(_ context.Context, _ string, _ time.Duration) (time.Duration, timeoutctx.Source) {
+					return time.Hour, timeoutctx.SourceProviderDefault
+				},
+			},
+			value:          testValue{testObject(types.StringNull(), types.StringNull())},
+			expectedSource: timeoutctx.SourceProviderDefault,
+			expectedPath:   path.Root("timeouts").AtName("create"),
+			expected:       time.Hour,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			timeoutsPath := test.timeoutsPath
+
+			if len(timeoutsPath.Steps()) == 0 {
+				timeoutsPath = path.Root("timeouts")
+			}
+
+			got, diags := test.core.Resolve(context.Background(), test.value, "create", 20*time.Minute, timeoutsPath)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got.Timeout, test.expected); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Source, test.expectedSource); diff != "" {
+				t.Errorf("unexpected source difference: %s", diff)
+			}
+
+			if !got.Path.Equal(test.expectedPath) {
+				t.Errorf("expected path %s, got %s", test.expectedPath, got.Path)
+			}
+		})
+	}
+}
+
+func TestCoreTimeout(t *testing.T) {
+	t.Parallel()
+
+	value := testValue{
+		types.ObjectValueMust(
+			map[string]attr.Type{
+				"create":   types.StringType,
+				"deadline": types.StringType,
+			},
+			map[string]attr.Value{
+				"create":   types.StringValue("10m"),
+				"deadline": types.StringValue("2030-01-02T15:04:05Z"),
+			},
+		),
+	}
+
+	type testCase struct {
+		operation string
+		expected  time.Duration
+	}
+	tests := map[string]testCase{
+		"configured": {
+			operation: "create",
+			expected:  10 * time.Minute,
+		},
+		"unsupported": {
+			operation: "unsupported",
+			expected:  20 * time.Minute,
+		},
+		// The deadline attribute is not an operation, so is not parsed.
+		"deadline": {
+			operation: "deadline",
+			expected:  20 * time.Minute,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCore.Timeout(context.Background(), value, test.operation, 20*time.Minute)
+
+			if diags.HasError() {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest // t.Setenv cannot be used in parallel tests
+func TestCoreResolveUnsupportedEnv(t *testing.T) {
+	t.Setenv("TF_TIMEOUTS_UNSUPPORTED", "1h")
+
+	got, diags := testCore.Resolve(context.Background(), testValue{testObject(types.StringNull(), types.StringNull())}, "unsupported", 20*time.Minute, path.Root("timeouts"))
+
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := resolution.Resolution{
+		Operation: "unsupported",
+		Timeout:   20 * time.Minute,
+		Source:    timeoutctx.SourceDefault,
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected resolution difference: %s", diff)
+	}
+}
+
+//nolint:paralleltest // t.Setenv cannot be used in parallel tests
+func TestCoreResolveUnconfigured(t *testing.T) {
+	t.Setenv("TF_TIMEOUTS_MULTIPLIER", "2")
+
+	core := timeoutsvalue.Core[testType, testValue]{
+		Operations: []string{"create"},
+		ProviderDefault: func(_ context.Context, _ string, _ time.Duration) (time.Duration, timeoutctx.Source) {
+			return 5 * time.Minute, timeoutctx.SourceProviderDefault
+		},
+	}
+
+	got, diags := core.ResolveUnconfigured(context.Background(), "import", 20*time.Minute)
+
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := resolution.Resolution{
+		Operation: "import",
+		Timeout:   10 * time.Minute,
+		Source:    timeoutctx.SourceProviderDefault,
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected resolution difference: %s", diff)
+	}
+}
+
+//nolint:paralleltest // t.Setenv cannot be used in parallel tests
+func TestCoreResolveEnvNotParseable(t *testing.T) {
+	t.Setenv("TF_TIMEOUTS_CREATE", "10x")
+
+	got, diags := testCore.Resolve(context.Background(), testValue{testObject(types.StringValue("10m"), types.StringNull())}, "create", 20*time.Minute, path.Root("timeouts"))
+
+	if !diags.HasError() {
+		t.Error("expected error diagnostics")
+	}
+
+	if diff := cmp.Diff(got.Timeout, 10*time.Minute); diff != "" {
+		t.Errorf("unexpected timeout difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.Source, timeoutctx.SourceConfig); diff != "" {
+		t.Errorf("unexpected source difference: %s", diff)
+	}
+}
+
+func TestCoreResolveNone(t *testing.T) {
+	t.Parallel()
+
+	value := testValue{testObject(types.StringValue("none"), types.StringNull())}
+
+	_, diags := testCore.Resolve(context.Background(), value, "create", 20*time.Minute, path.Root("timeouts"))
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Timeout Cannot Be Parsed",
+			`timeout for "create" cannot be parsed, timeout "none" is not supported`,
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diags difference: %s", diff)
+	}
+
+	allowNone := testCore
+	allowNone.AllowNone = true
+
+	got, diags := allowNone.Resolve(context.Background(), value, "create", 20*time.Minute, path.Root("timeouts"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	if diff := cmp.Diff(got.Timeout, duration.None); diff != "" {
+		t.Errorf("unexpected timeout difference: %s", diff)
+	}
+}
+
+func TestCoreNewValue(t *testing.T) {
+	t.Parallel()
+
+	core := timeoutsvalue.Core[testType, testValue]{
+		Operations: []string{"create", "delete"},
+	}
+
+	attrTypes := map[string]attr.Type{
+		"create":   types.StringType,
+		"deadline": types.StringType,
+	}
+
+	type testCase struct {
+		core          timeoutsvalue.Core[testType, testValue]
+		timeouts      map[string]time.Duration
+		expected      testValue
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"create": {
+			core: core,
+			timeouts: map[string]time.Duration{
+				"create": 30 * time.Minute,
+			},
+			expected: testValue{types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"create":   types.StringValue("30m0s"),
+				"deadline": types.StringNull(),
+			})},
+		},
+		"empty": {
+			core: core,
+			expected: testValue{types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"create":   types.StringNull(),
+				"deadline": types.StringNull(),
+			})},
+		},
+		"not-an-operation": {
+			core: core,
+			timeouts: map[string]time.Duration{
+				"deadline": 30 * time.Minute,
+			},
+			expected: testValue{types.ObjectUnknown(attrTypes)},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Timeouts Value",
+					`timeout for "deadline" cannot be set, it is not an operation, expected one of ["create" "delete"]`,
+				),
+			},
+		},
+		"not-supported": {
+			core: core,
+			timeouts: map[string]time.Duration{
+				"delete": 30 * time.Minute,
+			},
+			expected: testValue{types.ObjectUnknown(attrTypes)},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Timeouts Value",
+					`timeout for "delete" cannot be set, the operation is not supported by the timeouts attributes`,
+				),
+			},
+		},
+		"none-not-allowed": {
+			core: core,
+			timeouts: map[string]time.Duration{
+				"create": duration.None,
+			},
+			expected: testValue{types.ObjectUnknown(attrTypes)},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Timeouts Value",
+					`timeout for "create" cannot be set, "none" is not supported`,
+				),
+			},
+		},
+		"none-allowed": {
+			core: timeoutsvalue.Core[testType, testValue]{
+				Operations: []string{"create", "delete"},
+				AllowNone:  true,
+			},
+			timeouts: map[string]time.Duration{
+				"create": duration.None,
+			},
+			expected: testValue{types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"create":   types.StringValue("none"),
+				"deadline": types.StringNull(),
+			})},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := test.core.NewValue(attrTypes, test.timeouts)
+
+			if !test.core.ValueEqual(got, test.expected) {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+
+			if diff := cmp.Diff(gotDiags, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func resourceValue(attrValues map[string]attr.Value) resourcetimeouts.Value {
+	attrTypes := make(map[string]attr.Type, len(attrValues))
+
+	for name := range attrValues {
+		attrTypes[name] = types.StringType
+	}
+
+	return resourcetimeouts.Value{Object: types.ObjectValueMust(attrTypes, attrValues)}
+}
+
+func convertedValue(invoke types.String) testValue {
+	return testValue{types.ObjectValueMust(
+		map[string]attr.Type{
+			"invoke": timeoutsvalue.ConvertedStringType{},
+		},
+		map[string]attr.Value{
+			"invoke": timeoutsvalue.ConvertedString{StringValue: invoke},
+		},
+	)}
+}
+
+func TestCoreConvert(t *testing.T) {
+	t.Parallel()
+
+	core := timeoutsvalue.Core[testType, testValue]{
+		Operations: []string{"invoke"},
+	}
+
+	type testCase struct {
+		from          timeoutsvalue.Timeouts
+		operations    map[string]string
+		expected      testValue
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"configured": {
+			from: resourceValue(map[string]attr.Value{
+				"update": types.StringValue("2x"),
+			}),
+			operations: map[string]string{"invoke": "update"},
+			expected:   convertedValue(types.StringValue("2x")),
+		},
+		"not-configured": {
+			from: resourceValue(map[string]attr.Value{
+				"update": types.StringNull(),
+			}),
+			operations: map[string]string{"invoke": "update"},
+			expected:   convertedValue(types.StringNull()),
+		},
+		"sdkv2-default": {
+			from: resourceValue(map[string]attr.Value{
+				"update":  types.StringNull(),
+				"default": types.StringValue("30m"),
+			}),
+			operations: map[string]string{"invoke": "update"},
+			expected:   convertedValue(types.StringValue("30m")),
+		},
+		"sdkv2-default-not-applied": {
+			from: resourceValue(map[string]attr.Value{
+				"plan":    types.StringNull(),
+				"default": types.StringValue("30m"),
+			}),
+			operations: map[string]string{"invoke": "plan"},
+			expected:   convertedValue(types.StringNull()),
+		},
+		"deadline": {
+			from: resourceValue(map[string]attr.Value{
+				"update":   types.StringValue("10m"),
+				"deadline": types.StringValue("2006-01-02T15:04:05Z"),
+			}),
+			operations: map[string]string{"invoke": "update"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeouts Cannot Be Converted",
+					`"deadline" of timeouts.Value cannot be converted, it is not the timeout of an operation`,
+				),
+			},
+		},
+		"unsupported-operations": {
+			from: resourceValue(map[string]attr.Value{
+				"update": types.StringValue("10m"),
+			}),
+			operations: map[string]string{
+				"open":  "update",
+				"close": "update",
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeouts Cannot Be Converted",
+					`operation "close" is not supported, expected one of ["invoke"]`,
+				),
+				diag.NewErrorDiagnostic(
+					"Timeouts Cannot Be Converted",
+					`operation "open" is not supported, expected one of ["invoke"]`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := core.Convert(context.Background(), test.from, test.operations)
+
+			if diff := cmp.Diff(gotDiags, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !core.ValueEqual(got, test.expected) {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestCoreConvertResolve(t *testing.T) {
+	t.Parallel()
+
+	core := timeoutsvalue.Core[testType, testValue]{
+		Operations: []string{"invoke"},
+	}
+
+	got, diags := core.Resolve(context.Background(), convertedValue(types.StringValue("2x")), "invoke", 10*time.Minute, path.Root("timeouts"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := resolution.Resolution{
+		Operation: "invoke",
+		Timeout:   20 * time.Minute,
+		Source:    timeoutctx.SourceConfig,
+		Raw:       "2x",
+	}
+
+	// A converted value is not configured at a path within the timeouts path.
+	if len(got.Path.Steps()) != 0 {
+		t.Errorf("expected no path, got %s", got.Path)
+	}
+
+	got.Path = path.Path{}
+
+	if diff := cmp.Diff(got, expected, cmp.AllowUnexported(path.Path{})); diff != "" {
+		t.Errorf("unexpected resolution difference: %s", diff)
+	}
+}
+
+func TestCoreExplain(t *testing.T) {
+	t.Parallel()
+
+	core := timeoutsvalue.Core[testType, testValue]{
+		Operations: []string{"create", "read", "delete"},
+	}
+
+	value := testValue{types.ObjectValueMust(
+		map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+		},
+		map[string]attr.Value{
+			"create": types.StringValue("default+10m"),
+			"read":   types.StringNull(),
+		},
+	)}
+
+	got, diags := core.Explain(context.Background(), value, map[string]time.Duration{
+		"create": 20 * time.Minute,
+		"read":   5 * time.Minute,
+	}, path.Root("settings").AtName("timeouts"))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// The "delete" operation is not in the type of the value, so is not explained.
+	expected := resolution.Explanation{
+		Operations: []resolution.OperationExplanation{
+			{
+				Resolution: resolution.Resolution{
+					Operation: "create",
+					Timeout:   30 * time.Minute,
+					Source:    timeoutctx.SourceConfig,
+					Raw:       "default+10m",
+					Path:      path.Root("settings").AtName("timeouts").AtName("create"),
+				},
+				Steps: []string{
+					"default 20m0s",
+					`configuration "default+10m" resolves to 30m0s`,
+				},
+			},
+			{
+				Resolution: resolution.Resolution{
+					Operation: "read",
+					Timeout:   5 * time.Minute,
+					Source:    timeoutctx.SourceDefault,
+					Path:      path.Root("settings").AtName("timeouts").AtName("read"),
+				},
+				Steps: []string{
+					"default 5m0s",
+					"configuration is null or unknown, using default",
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected explanation difference: %s", diff)
+	}
+}
+
+func TestCoreValueAtPath(t *testing.T) {
+	t.Parallel()
+
+	object := testObject(types.StringValue("10m"), types.StringNull())
+
+	type testCase struct {
+		value         attr.Value
+		diags         diag.Diagnostics
+		expected      testValue
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"custom-type": {
+			value:    testValue{object},
+			expected: testValue{object},
+		},
+		"object-without-custom-type": {
+			value:    object,
+			expected: testValue{object},
+		},
+		"not-object": {
+			value: types.StringValue("10m"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts"),
+					"Timeouts Cannot Be Read",
+					"timeouts must be an object, got basetypes.StringValue",
+				),
+			},
+		},
+		"get-attribute-error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Value Conversion Error", "conversion failed"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Value Conversion Error", "conversion failed"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			getAttribute := func(_ context.Context, p path.Path, target interface{}) diag.Diagnostics {
+				if !p.Equal(path.Root("timeouts")) {
+					t.Errorf("unexpected path %s", p)
+				}
+
+				//nolint:forcetypeassert
+				*target.(*attr.Value) = test.value
+
+				return test.diags
+			}
+
+			got, gotDiags := testCore.ValueAtPath(context.Background(), getAttribute, path.Root("timeouts"))
+
+			if diff := cmp.Diff(gotDiags, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !testCore.ValueEqual(got, test.expected) {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
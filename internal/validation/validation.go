@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validation contains the validation of timeouts attribute values shared
+// by the framework validators and the protocol package, which cannot depend on
+// the framework.
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+)
+
+const (
+	// DescriptionTimeDuration describes the values accepted by TimeDuration.
+	DescriptionTimeDuration = `must be a string containing a sequence of decimal numbers, each with optional fraction and a unit suffix, such as "300ms", "-1.5h" or "2h45m", or an expression relative to the default timeout, such as "2x", "150%", "default+10m" or "default-5m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".`
+
+	// DescriptionNone is appended to DescriptionTimeDuration when "none" is
+	// accepted.
+	DescriptionNone = ` The value "none" disables the timeout.`
+
+	// DescriptionRFC3339 describes the values accepted by RFC3339.
+	DescriptionRFC3339 = `must be a string containing an RFC 3339 timestamp, such as "2026-11-01T06:00:00Z" or "2026-11-01T07:00:00+01:00".`
+)
+
+// TimeDuration returns an error if s cannot be parsed as a timeout, or is "none"
+// and allowNone is false. The error message is s followed by the description of
+// the accepted values.
+func TimeDuration(s string, allowNone bool) error {
+	if err := duration.Validate(s, allowNone); err != nil {
+		description := DescriptionTimeDuration
+
+		if allowNone {
+			description += DescriptionNone
+		}
+
+		return fmt.Errorf("%q %s", s, description)
+	}
+
+	return nil
+}
+
+// RFC3339 returns an error if s cannot be parsed as an RFC 3339 timestamp. The
+// error message is s followed by the description of the accepted values.
+func RFC3339(s string) error {
+	if _, err := time.Parse(time.RFC3339, s); err != nil {
+		return fmt.Errorf("%q %s", s, DescriptionRFC3339)
+	}
+
+	return nil
+}
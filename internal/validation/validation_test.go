@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/validation"
+)
+
+func TestTimeDuration(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       string
+		allowNone   bool
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"valid": {
+			input: "30m",
+		},
+		"relative": {
+			input: "2x",
+		},
+		"none-allowed": {
+			input:     "none",
+			allowNone: true,
+		},
+		"none-not-allowed": {
+			input:       "none",
+			expectedErr: `"none" ` + validation.DescriptionTimeDuration,
+		},
+		"invalid": {
+			input:       "10y",
+			expectedErr: `"10y" ` + validation.DescriptionTimeDuration,
+		},
+		"invalid-none-allowed": {
+			input:       "10y",
+			allowNone:   true,
+			expectedErr: `"10y" ` + validation.DescriptionTimeDuration + validation.DescriptionNone,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotErr string
+			if err := validation.TimeDuration(test.input, test.allowNone); err != nil {
+				gotErr = err.Error()
+			}
+
+			if diff := cmp.Diff(gotErr, test.expectedErr); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339(t *testing.T) {
+	t.Parallel()
+
+	if err := validation.RFC3339("2026-11-01T06:00:00Z"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	err := validation.RFC3339("tomorrow")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if diff := cmp.Diff(err.Error(), `"tomorrow" `+validation.DescriptionRFC3339); diff != "" {
+		t.Errorf("unexpected err difference: %s", diff)
+	}
+}
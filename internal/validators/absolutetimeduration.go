@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = absoluteTimeDurationValidator{}
+
+// absoluteTimeDurationValidator validates that a string Attribute's value is parseable as
+// time.Duration. Unlike timeDurationValidator, expressions relative to the default timeout
+// are not accepted.
+type absoluteTimeDurationValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (validator absoluteTimeDurationValidator) Description(_ context.Context) string {
+	return `must be a string containing a sequence of decimal numbers, each with optional fraction and a unit suffix, such as "300ms", "-1.5h" or "2h45m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".`
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator absoluteTimeDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator absoluteTimeDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	s := req.ConfigValue
+
+	if s.IsUnknown() || s.IsNull() {
+		return
+	}
+
+	if _, err := time.ParseDuration(s.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			req.Path,
+			"Invalid Attribute Value Time Duration",
+			fmt.Sprintf("%q %s", s.ValueString(), validator.Description(ctx))),
+		)
+		return
+	}
+}
+
+// AbsoluteTimeDuration returns an AttributeValidator which ensures that any
+// configured attribute value:
+//
+//   - Is parseable as time duration.
+//
+// It is used for attributes, such as provider default timeouts, which have no
+// default timeout for relative expressions to refer to.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func AbsoluteTimeDuration() validator.String {
+	return absoluteTimeDurationValidator{}
+}
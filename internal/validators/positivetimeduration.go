@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = positiveTimeDurationValidator{}
+
+// positiveTimeDurationValidator validates that a string Attribute's value is parseable as
+// time.Duration and greater than zero. Like absoluteTimeDurationValidator, expressions
+// relative to the default timeout are not accepted.
+type positiveTimeDurationValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (validator positiveTimeDurationValidator) Description(_ context.Context) string {
+	return `must be a string containing a sequence of decimal numbers, each with optional fraction and a unit suffix, such as "300ms", "1.5h" or "2h45m", which is greater than zero. Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".`
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator positiveTimeDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator positiveTimeDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	s := req.ConfigValue
+
+	if s.IsUnknown() || s.IsNull() {
+		return
+	}
+
+	if d, err := time.ParseDuration(s.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			req.Path,
+			"Invalid Attribute Value Time Duration",
+			fmt.Sprintf("%q %s", s.ValueString(), validator.Description(ctx))),
+		)
+		return
+	}
+}
+
+// PositiveTimeDuration returns an AttributeValidator which ensures that any
+// configured attribute value:
+//
+//   - Is parseable as time duration.
+//   - Is greater than zero.
+//
+// It is used for provider default timeouts, where a zero default would be
+// treated as unset and a negative default would expire every operation.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func PositiveTimeDuration() validator.String {
+	return positiveTimeDurationValidator{}
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/validation"
+)
+
+var _ validator.String = rfc3339Validator{}
+
+// rfc3339Validator validates that a string Attribute's value is parseable as an RFC 3339 timestamp.
+type rfc3339Validator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (validator rfc3339Validator) Description(_ context.Context) string {
+	return validation.DescriptionRFC3339
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	s := req.ConfigValue
+
+	if s.IsUnknown() || s.IsNull() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, s.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			req.Path,
+			"Invalid Attribute Value RFC 3339 Timestamp",
+			fmt.Sprintf("%q %s", s.ValueString(), validator.Description(ctx))),
+		)
+		return
+	}
+}
+
+// RFC3339 returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is parseable as an RFC 3339 timestamp.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func RFC3339() validator.String {
+	return rfc3339Validator{}
+}
@@ -6,21 +6,24 @@ package validators
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/validation"
 )
 
 var _ validator.String = timeDurationValidator{}
 
-// timeDurationValidator validates that a string Attribute's value is parseable as time.Duration.
+// timeDurationValidator validates that a string Attribute's value is parseable as time.Duration,
+// or is an expression relative to the default timeout.
 type timeDurationValidator struct {
 }
 
 // Description describes the validation in plain text formatting.
 func (validator timeDurationValidator) Description(_ context.Context) string {
-	return `must be a string containing a sequence of decimal numbers, each with optional fraction and a unit suffix, such as "300ms", "-1.5h" or "2h45m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".`
+	return validation.DescriptionTimeDuration
 }
 
 // MarkdownDescription describes the validation in Markdown formatting.
@@ -36,7 +39,7 @@ func (validator timeDurationValidator) ValidateString(ctx context.Context, req v
 		return
 	}
 
-	if _, err := time.ParseDuration(s.ValueString()); err != nil {
+	if err := duration.Validate(s.ValueString(), false); err != nil {
 		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
 			req.Path,
 			"Invalid Attribute Value Time Duration",
@@ -49,7 +52,8 @@ func (validator timeDurationValidator) ValidateString(ctx context.Context, req v
 // TimeDuration returns an AttributeValidator which ensures that any configured
 // attribute value:
 //
-//   - Is parseable as time duration.
+//   - Is parseable as time duration, or is an expression relative to the default
+//     timeout, such as "2x", "150%", "default+10m" or "default-5m".
 //
 // Null (unconfigured) and unknown (known after apply) values are skipped.
 func TimeDuration() validator.String {
@@ -34,13 +34,32 @@ func TestTimeDuration(t *testing.T) {
 		"valid": {
 			val: types.StringValue("20m"),
 		},
+		"valid-relative-multiple": {
+			val: types.StringValue("2x"),
+		},
+		"valid-relative-percentage": {
+			val: types.StringValue("150%"),
+		},
+		"valid-relative-default-plus": {
+			val: types.StringValue("default+10m"),
+		},
+		"invalid-none": {
+			val: types.StringValue("none"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Time Duration",
+					`"none" must be a string containing a sequence of decimal numbers, each with optional fraction and a unit suffix, such as "300ms", "-1.5h" or "2h45m", or an expression relative to the default timeout, such as "2x", "150%", "default+10m" or "default-5m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".`,
+				),
+			},
+		},
 		"invalid": {
-			val: types.StringValue("20x"),
+			val: types.StringValue("20y"),
 			expectedDiagnostics: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
 					path.Root("test"),
 					"Invalid Attribute Value Time Duration",
-					`"20x" must be a string containing a sequence of decimal numbers, each with optional fraction and a unit suffix, such as "300ms", "-1.5h" or "2h45m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".`,
+					`"20y" must be a string containing a sequence of decimal numbers, each with optional fraction and a unit suffix, such as "300ms", "-1.5h" or "2h45m", or an expression relative to the default timeout, such as "2x", "150%", "default+10m" or "default-5m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".`,
 				),
 			},
 		},
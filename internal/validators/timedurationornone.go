@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/validation"
+)
+
+var _ validator.String = timeDurationOrNoneValidator{}
+
+// timeDurationOrNoneValidator validates that a string Attribute's value is accepted by
+// timeDurationValidator, or is "none" to indicate that no timeout should be applied.
+type timeDurationOrNoneValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (validator timeDurationOrNoneValidator) Description(ctx context.Context) string {
+	return timeDurationValidator{}.Description(ctx) + validation.DescriptionNone
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator timeDurationOrNoneValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator timeDurationOrNoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	s := req.ConfigValue
+
+	if s.IsUnknown() || s.IsNull() {
+		return
+	}
+
+	if err := duration.Validate(s.ValueString(), true); err != nil {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			req.Path,
+			"Invalid Attribute Value Time Duration",
+			fmt.Sprintf("%q %s", s.ValueString(), validator.Description(ctx))),
+		)
+		return
+	}
+}
+
+// TimeDurationOrNone returns an AttributeValidator which ensures that any
+// configured attribute value:
+//
+//   - Is parseable as time duration, or is an expression relative to the default
+//     timeout, such as "2x", "150%", "default+10m" or "default-5m".
+//   - Or is "none", indicating that no timeout should be applied.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func TimeDurationOrNone() validator.String {
+	return timeDurationOrNoneValidator{}
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/list/timeouts"
+)
+
+// The steps of the explanation are tested with the internal timeoutsvalue
+// package.
+func TestTimeoutsValueExplain(t *testing.T) {
+	t.Parallel()
+
+	timeoutsValue := timeouts.NewValueMust(timeouts.Opts{}, map[string]time.Duration{
+		"list": 30 * time.Minute,
+	})
+
+	got, diags := timeoutsValue.Explain(context.Background(), map[string]time.Duration{
+		"list": 20 * time.Minute,
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if len(got.Operations) != 1 {
+		t.Fatalf("expected 1 operation, got %d", len(got.Operations))
+	}
+
+	r := got.Operations[0].Resolution
+
+	if r.Timeout != 30*time.Minute {
+		t.Errorf("expected list timeout %s, got %s", 30*time.Minute, r.Timeout)
+	}
+
+	if expected := path.Root("timeouts").AtName("list"); !r.Path.Equal(expected) {
+		t.Errorf("expected path %s, got %s", expected, r.Path)
+	}
+}
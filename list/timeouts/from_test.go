@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/list/timeouts"
+)
+
+// The conversion of the value is tested with the internal timeoutsvalue package.
+func TestFromConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+
+	config := tfsdk.Config{
+		Schema: s,
+		Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"timeouts": tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.String, "10m"),
+			}),
+		}),
+	}
+
+	got, diags := timeouts.FromConfig(ctx, config)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	gotList, _ := got.List(ctx, 20*time.Minute)
+
+	if gotList != 10*time.Minute {
+		t.Errorf("expected list timeout %s, got %s", 10*time.Minute, gotList)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/list/timeouts"
+)
+
+func TestForRequest(t *testing.T) {
+	t.Parallel()
+
+	if got := timeouts.OperationForRequest(list.ListRequest{}); got != timeouts.OperationList {
+		t.Errorf("expected %q, got %q", timeouts.OperationList, got)
+	}
+
+	value := timeouts.NewValueMust(timeouts.Opts{}, map[string]time.Duration{
+		"list": 30 * time.Minute,
+	})
+
+	got, diags := timeouts.ForRequest(context.Background(), value, list.ListRequest{}, 20*time.Minute)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if got != 30*time.Minute {
+		t.Errorf("expected list timeout %s, got %s", 30*time.Minute, got)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NewValue returns a known Value with an attribute for the "list" operation. If
+// timeouts contains the "list" key, the attribute is set to the duration
+// formatted as a string, otherwise it is null. An error diagnostic is returned,
+// along with an unknown Value, if timeouts contains any other key, or the maximum
+// duration, as "none" is not supported for this operation. Opts only holds the
+// attribute description, so is ignored.
+func NewValue(_ Opts, timeouts map[string]time.Duration) (Value, diag.Diagnostics) {
+	return core.NewValue(attrTypesMap(), timeouts)
+}
+
+// NewValueMust is the same as NewValue, but panics if any error diagnostics are
+// generated. It is intended for use in tests.
+func NewValueMust(opts Opts, timeouts map[string]time.Duration) Value {
+	value, diags := NewValue(opts, timeouts)
+	if diags.HasError() {
+		panic(fmt.Sprintf("NewValueMust received error diagnostics: %v", diags))
+	}
+
+	return value
+}
+
+// NullValue returns a null Value, such as when the timeouts block or attribute is
+// not configured.
+func NullValue(_ Opts) Value {
+	return Value{
+		Object: types.ObjectNull(attrTypesMap()),
+	}
+}
+
+// UnknownValue returns an unknown Value.
+func UnknownValue(_ Opts) Value {
+	return Value{
+		Object: types.ObjectUnknown(attrTypesMap()),
+	}
+}
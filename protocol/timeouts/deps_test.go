@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// TestDependencies ensures that the package can be used by providers without the
+// framework, as none of its dependencies are framework packages.
+func TestDependencies(t *testing.T) {
+	t.Parallel()
+
+	out, err := exec.Command("go", "list", "-deps", ".").Output()
+	if err != nil {
+		t.Fatalf("unexpected error listing dependencies: %s", err)
+	}
+
+	for _, dependency := range strings.Fields(string(out)) {
+		if dependency == "github.com/hashicorp/terraform-plugin-framework" || strings.HasPrefix(dependency, "github.com/hashicorp/terraform-plugin-framework/") {
+			t.Errorf("unexpected dependency on %s", dependency)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+// TimeoutError is the cause, returned by context.Cause, of the cancellation of a
+// context returned by Value.Context. It records the operation, its timeout, where
+// the timeout was resolved from and the time elapsed before the deadline. It wraps
+// context.DeadlineExceeded, so errors.Is(err, context.DeadlineExceeded) is true.
+//
+// The same type is used by every timeouts package, so errors.As matches a
+// TimeoutError regardless of the package which created the context.
+type TimeoutError = timeoutctx.TimeoutError
+
+// Source describes where a timeout was resolved from.
+type Source = timeoutctx.Source
+
+const (
+	// SourceConfig indicates the timeout was set in the timeouts configuration.
+	SourceConfig = timeoutctx.SourceConfig
+
+	// SourceDefault indicates the timeout was not configured, so a default was
+	// used.
+	SourceDefault = timeoutctx.SourceDefault
+
+	// SourceOverride indicates the timeout was forced by an environment variable.
+	SourceOverride = timeoutctx.SourceOverride
+
+	// SourceDeadline indicates the operation was limited by the "deadline"
+	// attribute rather than by its timeout.
+	SourceDeadline = timeoutctx.SourceDeadline
+)
+
+// ValidationError is returned by Value.Validate for each attribute whose value is
+// not accepted.
+type ValidationError struct {
+	// Attribute is the name of the attribute within the timeouts object, such
+	// as "create", or empty if the timeouts object itself cannot be read.
+	Attribute string
+
+	// Err describes why the value is not accepted.
+	Err error
+}
+
+// Error returns the name of the attribute and the reason its value is not
+// accepted.
+func (e *ValidationError) Error() string {
+	if e.Attribute == "" {
+		return fmt.Sprintf("%s: %s", AttributeName, e.Err)
+	}
+
+	return fmt.Sprintf("%s.%s: %s", AttributeName, e.Attribute, e.Err)
+}
+
+// Unwrap returns Err.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// AttributePath returns the path of the attribute, assuming the timeouts block or
+// attribute is at the root of the schema, for use in diagnostics.
+func (e *ValidationError) AttributePath() *tftypes.AttributePath {
+	attributePath := tftypes.NewAttributePath().WithAttributeName(AttributeName)
+
+	if e.Attribute == "" {
+		return attributePath
+	}
+
+	return attributePath.WithAttributeName(e.Attribute)
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timeouts provides timeouts for providers implemented directly with
+// terraform-plugin-go, without the framework. It generates the timeouts block or
+// attribute for protocol version 5 and 6 schemas, and resolves timeouts from
+// tftypes values, following the same validation and parsing rules as the
+// framework packages.
+package timeouts
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/tftimeouts"
+)
+
+const (
+	// AttributeName is the name of the timeouts block or attribute, which must
+	// be at the root of the schema.
+	AttributeName = tftimeouts.AttributeName
+
+	attributeNameDeadline = tftimeouts.AttributeNameDeadline
+)
+
+// Opts is used as an argument to Block5, Block6 and Attribute6 to indicate which
+// attributes should be created and whether supplied descriptions should override
+// default descriptions.
+type Opts struct {
+	// Operations contains the names of the attributes to create for each
+	// operation, such as "create" or "read".
+	Operations []string
+
+	// Descriptions contains descriptions, keyed by attribute name, which
+	// override the default descriptions.
+	Descriptions map[string]string
+
+	// AllowNoTimeout indicates that the duration attributes should accept "none",
+	// for which Value.Timeout returns NoTimeout.
+	AllowNoTimeout bool
+
+	// Deadline indicates that a "deadline" attribute should be created, which
+	// accepts an RFC 3339 timestamp by which all operations must complete.
+	Deadline bool
+}
+
+// Type returns the tftypes.Object of the timeouts block or attribute generated
+// for opts.
+func Type(opts Opts) tftypes.Object {
+	attributeTypes := map[string]tftypes.Type{}
+
+	for _, name := range attributeNames(opts) {
+		attributeTypes[name] = tftypes.String
+	}
+
+	return tftypes.Object{
+		AttributeTypes: attributeTypes,
+	}
+}
+
+// Block5 returns a single nested "timeouts" block for a protocol version 5
+// schema, containing an optional string attribute for each of the operations in
+// opts. Values are not validated by Terraform, so Value.Validate should be called
+// when validating the configuration.
+func Block5(opts Opts) *tfprotov5.SchemaNestedBlock {
+	var attributes []*tfprotov5.SchemaAttribute
+
+	for _, name := range attributeNames(opts) {
+		attributes = append(attributes, &tfprotov5.SchemaAttribute{
+			Name:            name,
+			Type:            tftypes.String,
+			Description:     description(opts, name),
+			DescriptionKind: tfprotov5.StringKindPlain,
+			Optional:        true,
+		})
+	}
+
+	return &tfprotov5.SchemaNestedBlock{
+		TypeName: AttributeName,
+		Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: attributes,
+		},
+	}
+}
+
+// Block6 returns a single nested "timeouts" block for a protocol version 6
+// schema, containing an optional string attribute for each of the operations in
+// opts. Values are not validated by Terraform, so Value.Validate should be called
+// when validating the configuration.
+func Block6(opts Opts) *tfprotov6.SchemaNestedBlock {
+	return &tfprotov6.SchemaNestedBlock{
+		TypeName: AttributeName,
+		Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: attributes6(opts),
+		},
+	}
+}
+
+// Attribute6 returns an optional single nested "timeouts" attribute for a
+// protocol version 6 schema, containing an optional string attribute for each of
+// the operations in opts. Values are not validated by Terraform, so
+// Value.Validate should be called when validating the configuration.
+func Attribute6(opts Opts) *tfprotov6.SchemaAttribute {
+	return &tfprotov6.SchemaAttribute{
+		Name: AttributeName,
+		NestedType: &tfprotov6.SchemaObject{
+			Nesting:    tfprotov6.SchemaObjectNestingModeSingle,
+			Attributes: attributes6(opts),
+		},
+		Optional: true,
+	}
+}
+
+func attributes6(opts Opts) []*tfprotov6.SchemaAttribute {
+	var attributes []*tfprotov6.SchemaAttribute
+
+	for _, name := range attributeNames(opts) {
+		attributes = append(attributes, &tfprotov6.SchemaAttribute{
+			Name:            name,
+			Type:            tftypes.String,
+			Description:     description(opts, name),
+			DescriptionKind: tfprotov6.StringKindPlain,
+			Optional:        true,
+		})
+	}
+
+	return attributes
+}
+
+// attributeNames returns the sorted names of the attributes generated for opts.
+func attributeNames(opts Opts) []string {
+	names := append([]string{}, opts.Operations...)
+
+	if opts.Deadline {
+		names = append(names, attributeNameDeadline)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func description(opts Opts, name string) string {
+	if d, ok := opts.Descriptions[name]; ok {
+		return d
+	}
+
+	if name == attributeNameDeadline {
+		return `A string containing an RFC 3339 timestamp, such as "2026-11-01T06:00:00Z", by which all ` +
+			`operations must complete. Operations are limited by the earlier of this deadline and the ` +
+			`timeout for the operation.`
+	}
+
+	d := `A string that can be parsed as a duration consisting of numbers and unit suffixes, such as ` +
+		`"30s" or "2h45m". Valid time units are "s" (seconds), "m" (minutes), "h" (hours).`
+
+	if opts.AllowNoTimeout {
+		d += ` The value "none" disables the timeout.`
+	}
+
+	return d
+}
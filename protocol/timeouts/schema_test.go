@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/protocol/timeouts"
+)
+
+func TestType(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.Type(timeouts.Opts{
+		Operations: []string{"create", "delete"},
+		Deadline:   true,
+	})
+
+	expected := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create":   tftypes.String,
+			"deadline": tftypes.String,
+			"delete":   tftypes.String,
+		},
+	}
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestBlock5(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.Block5(timeouts.Opts{
+		Operations: []string{"read", "create"},
+		Descriptions: map[string]string{
+			"read": "read description",
+		},
+	})
+
+	expected := &tfprotov5.SchemaNestedBlock{
+		TypeName: "timeouts",
+		Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name: "create",
+					Type: tftypes.String,
+					Description: `A string that can be parsed as a duration consisting of numbers and unit suffixes, ` +
+						`such as "30s" or "2h45m". Valid time units are "s" (seconds), "m" (minutes), "h" (hours).`,
+					DescriptionKind: tfprotov5.StringKindPlain,
+					Optional:        true,
+				},
+				{
+					Name:            "read",
+					Type:            tftypes.String,
+					Description:     "read description",
+					DescriptionKind: tfprotov5.StringKindPlain,
+					Optional:        true,
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected block difference: %s", diff)
+	}
+}
+
+func TestBlock6(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.Block6(timeouts.Opts{
+		Operations:     []string{"create"},
+		AllowNoTimeout: true,
+	})
+
+	expected := &tfprotov6.SchemaNestedBlock{
+		TypeName: "timeouts",
+		Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{
+					Name: "create",
+					Type: tftypes.String,
+					Description: `A string that can be parsed as a duration consisting of numbers and unit suffixes, ` +
+						`such as "30s" or "2h45m". Valid time units are "s" (seconds), "m" (minutes), "h" (hours). ` +
+						`The value "none" disables the timeout.`,
+					DescriptionKind: tfprotov6.StringKindPlain,
+					Optional:        true,
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected block difference: %s", diff)
+	}
+}
+
+func TestAttribute6(t *testing.T) {
+	t.Parallel()
+
+	opts := timeouts.Opts{
+		Operations: []string{"create"},
+		Deadline:   true,
+	}
+
+	got := timeouts.Attribute6(opts)
+
+	if got.Name != "timeouts" || !got.Optional {
+		t.Errorf("expected optional timeouts attribute, got %v", got)
+	}
+
+	if !got.ValueType().Equal(timeouts.Type(opts)) {
+		t.Errorf("expected type %s, got %s", timeouts.Type(opts), got.ValueType())
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/tftimeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/validation"
+)
+
+// NoTimeout is returned by Value.Timeout when the timeout has been configured as
+// "none", which is accepted when Opts.AllowNoTimeout is set.
+const NoTimeout = duration.None
+
+// core returns the Core resolving the named operation as for the framework
+// packages. The operations are set by Opts, which a Value does not know, so every
+// attribute other than "deadline" is an operation. Value.Validate rejects "none"
+// unless Opts.AllowNoTimeout is set, so it is always accepted here.
+func core(name string) tftimeouts.Core {
+	c := tftimeouts.Core{
+		AllowNone: true,
+	}
+
+	if name != attributeNameDeadline {
+		c.Operations = []string{name}
+	}
+
+	return c
+}
+
+// Value is the timeouts object of a configuration, plan or state.
+type Value struct {
+	object tftypes.Value
+}
+
+// NewValue returns a Value for the supplied timeouts object, which is typically
+// of the Type generated for the schema.
+func NewValue(object tftypes.Value) Value {
+	return Value{
+		object: object,
+	}
+}
+
+// FromObject returns the Value of the timeouts block or attribute within the
+// supplied object, such as the configuration, plan or state of a resource,
+// unmarshalled from a DynamicValue. If the object is null or unknown, or does not
+// contain the timeouts block or attribute, the returned Value is null and its
+// timeouts are the supplied defaults.
+func FromObject(object tftypes.Value) Value {
+	return NewValue(tftimeouts.FromObject(object))
+}
+
+// Timeout returns the timeout for the named operation, such as "create". If the
+// timeout has not been set, is null or unknown, the supplied default timeout is
+// used. Relative expressions, such as "2x", are resolved against the default
+// timeout, and environment variable overrides are applied, as for the framework
+// packages. If the timeout cannot be parsed, the error is returned along with the
+// supplied default timeout.
+func (v Value) Timeout(ctx context.Context, name string, defaultTimeout time.Duration) (time.Duration, error) {
+	r, err := tftimeouts.Resolve(ctx, core(name), v.object, name, defaultTimeout)
+
+	return r.Timeout, err
+}
+
+// Deadline returns the "deadline" attribute as a time.Time, and whether it has
+// been set. An error is returned if the deadline cannot be parsed.
+func (v Value) Deadline() (time.Time, bool, error) {
+	return tftimeouts.Deadline(v.object)
+}
+
+// Context returns a copy of ctx which is cancelled after the timeout returned by
+// Timeout for the named operation, or at the "deadline" attribute if earlier,
+// along with its cancel function, which should be deferred by the caller. The
+// cause of the cancellation, returned by context.Cause, is a *TimeoutError. If any
+// errors are generated they are returned along with a context derived from the
+// supplied default timeout.
+func (v Value) Context(ctx context.Context, name string, defaultTimeout time.Duration) (context.Context, context.CancelFunc, error) {
+	r, timeoutErr := tftimeouts.Resolve(ctx, core(name), v.object, name, defaultTimeout)
+	timeout := r.Timeout
+
+	deadline, ok, deadlineErr := tftimeouts.Deadline(v.object)
+
+	err := errors.Join(timeoutErr, deadlineErr)
+
+	if ok && (timeout == NoTimeout || deadline.Before(time.Now().Add(timeout))) {
+		ctx, cancel := timeoutctx.WithDeadline(ctx, name, deadline, timeout)
+
+		return ctx, cancel, err
+	}
+
+	ctx, cancel := timeoutctx.WithTimeout(ctx, name, timeout, r.Source)
+
+	return ctx, cancel, err
+}
+
+// Validate returns a *ValidationError for each attribute whose value cannot be
+// parsed, applying the same validation as the framework packages. Duration
+// attributes accept "none" only if opts.AllowNoTimeout is set, and the "deadline"
+// attribute must be an RFC 3339 timestamp. Null and unknown values are skipped.
+func (v Value) Validate(opts Opts) []*ValidationError {
+	if v.object.Type() == nil || !v.object.IsKnown() || v.object.IsNull() {
+		return nil
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := v.object.As(&attributes); err != nil {
+		return []*ValidationError{
+			{
+				Err: err,
+			},
+		}
+	}
+
+	var names []string
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var validationErrs []*ValidationError
+
+	for _, name := range names {
+		value := attributes[name]
+
+		if !value.IsKnown() || value.IsNull() {
+			continue
+		}
+
+		var s string
+
+		err := value.As(&s)
+
+		if err == nil {
+			if name == attributeNameDeadline {
+				err = validation.RFC3339(s)
+			} else {
+				err = validation.TimeDuration(s, opts.AllowNoTimeout)
+			}
+		}
+
+		if err != nil {
+			validationErrs = append(validationErrs, &ValidationError{
+				Attribute: name,
+				Err:       err,
+			})
+		}
+	}
+
+	return validationErrs
+}
+
+// IsNull returns whether the timeouts object is null, or has not been set.
+func (v Value) IsNull() bool {
+	return v.object.Type() == nil || v.object.IsNull()
+}
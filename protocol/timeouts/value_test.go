@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/protocol/timeouts"
+)
+
+var testOpts = timeouts.Opts{
+	Operations: []string{"create", "read"},
+	Deadline:   true,
+}
+
+func testValue(create, read, deadline interface{}) timeouts.Value {
+	return timeouts.NewValue(tftypes.NewValue(timeouts.Type(testOpts), map[string]tftypes.Value{
+		"create":   tftypes.NewValue(tftypes.String, create),
+		"read":     tftypes.NewValue(tftypes.String, read),
+		"deadline": tftypes.NewValue(tftypes.String, deadline),
+	}))
+}
+
+func TestValueTimeout(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		value           timeouts.Value
+		expectedTimeout time.Duration
+		expectedErr     string
+	}
+	tests := map[string]testCase{
+		"zero": {
+			value:           timeouts.Value{},
+			expectedTimeout: 20 * time.Minute,
+		},
+		"null": {
+			value:           timeouts.NewValue(tftypes.NewValue(timeouts.Type(testOpts), nil)),
+			expectedTimeout: 20 * time.Minute,
+		},
+		"not-set": {
+			value:           testValue(nil, "1h", nil),
+			expectedTimeout: 20 * time.Minute,
+		},
+		"absolute": {
+			value:           testValue("1h", nil, nil),
+			expectedTimeout: time.Hour,
+		},
+		"relative": {
+			value:           testValue("150%", nil, nil),
+			expectedTimeout: 30 * time.Minute,
+		},
+		"none": {
+			value:           testValue("none", nil, nil),
+			expectedTimeout: timeouts.NoTimeout,
+		},
+		"invalid": {
+			value:           testValue("10y", nil, nil),
+			expectedTimeout: 20 * time.Minute,
+			expectedErr:     `timeout for "create" cannot be parsed, time: unknown unit "y" in duration "10y"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotTimeout, gotErr := test.value.Timeout(context.Background(), "create", 20*time.Minute)
+
+			if diff := cmp.Diff(gotTimeout, test.expectedTimeout); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+
+			var gotErrString string
+			if gotErr != nil {
+				gotErrString = gotErr.Error()
+			}
+
+			if diff := cmp.Diff(gotErrString, test.expectedErr); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromObject(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"timeouts": timeouts.Type(testOpts),
+		},
+	}
+
+	value := timeouts.FromObject(tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"timeouts": tftypes.NewValue(timeouts.Type(testOpts), map[string]tftypes.Value{
+			"create":   tftypes.NewValue(tftypes.String, "45m"),
+			"read":     tftypes.NewValue(tftypes.String, nil),
+			"deadline": tftypes.NewValue(tftypes.String, nil),
+		}),
+	}))
+
+	got, err := value.Timeout(context.Background(), "create", 20*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != 45*time.Minute {
+		t.Errorf("expected %s, got %s", 45*time.Minute, got)
+	}
+
+	if !timeouts.FromObject(tftypes.NewValue(objectType, nil)).IsNull() {
+		t.Error("expected value from null object to be null")
+	}
+}
+
+func TestValueContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel, err := testValue("1ns", nil, nil).Context(context.Background(), "create", 20*time.Minute)
+	defer cancel()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	<-ctx.Done()
+
+	var timeoutErr *timeouts.TimeoutError
+
+	if !errors.As(context.Cause(ctx), &timeoutErr) {
+		t.Fatalf("expected cause to be *timeouts.TimeoutError, got %T", context.Cause(ctx))
+	}
+
+	if timeoutErr.Source != timeouts.SourceConfig {
+		t.Errorf("expected source %q, got %q", timeouts.SourceConfig, timeoutErr.Source)
+	}
+}
+
+func TestValueContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Now().Add(10 * time.Minute).UTC().Truncate(time.Second)
+
+	ctx, cancel, err := testValue("1h", nil, deadline.Format(time.RFC3339)).Context(context.Background(), "create", 20*time.Minute)
+	defer cancel()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, ok := ctx.Deadline()
+	if !ok {
+		t.Fatal("expected context deadline")
+	}
+
+	if !got.Equal(deadline) {
+		t.Errorf("expected deadline %s, got %s", deadline, got)
+	}
+}
+
+func TestValueValidate(t *testing.T) {
+	t.Parallel()
+
+	if got := testValue("30m", "2x", "2026-11-01T06:00:00Z").Validate(testOpts); len(got) > 0 {
+		t.Errorf("unexpected validation errors: %v", got)
+	}
+
+	got := testValue("none", "10y", "tomorrow").Validate(testOpts)
+
+	var gotAttributes []string
+
+	for _, validationErr := range got {
+		gotAttributes = append(gotAttributes, validationErr.AttributePath().String())
+	}
+
+	expected := []string{
+		`AttributeName("timeouts").AttributeName("create")`,
+		`AttributeName("timeouts").AttributeName("deadline")`,
+		`AttributeName("timeouts").AttributeName("read")`,
+	}
+
+	if diff := cmp.Diff(gotAttributes, expected); diff != "" {
+		t.Errorf("unexpected attributes difference: %s", diff)
+	}
+
+	allowNoTimeout := testOpts
+	allowNoTimeout.AllowNoTimeout = true
+
+	if got := testValue("none", nil, nil).Validate(allowNoTimeout); len(got) > 0 {
+		t.Errorf("unexpected validation errors: %v", got)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/provider/timeouts"
+)
+
+// The cause of the context is tested with the internal timeoutctx package.
+func TestTimeoutsValueConfigureContext(t *testing.T) {
+	t.Parallel()
+
+	timeoutsValue := timeouts.NewValueMust(timeouts.Opts{}, map[string]time.Duration{
+		"configure": 30 * time.Minute,
+	})
+
+	start := time.Now()
+
+	ctx, cancel, diags := timeoutsValue.ConfigureContext(context.Background(), 20*time.Minute)
+	defer cancel()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		t.Fatal("expected a deadline")
+	}
+
+	if got := deadline.Sub(start); got < 30*time.Minute || got > 31*time.Minute {
+		t.Errorf("expected a deadline 30m from the start, got %s", got)
+	}
+}
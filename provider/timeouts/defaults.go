@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/validators"
+	resourcetimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+const (
+	defaultsAttributeNameCreate = "create"
+	defaultsAttributeNameRead   = "read"
+	defaultsAttributeNameUpdate = "update"
+	defaultsAttributeNameDelete = "delete"
+	defaultsAttributeNamePlan   = "plan"
+	defaultsAttributeNameImport = "import"
+)
+
+var (
+	_ basetypes.ObjectTypable  = DefaultsType{}
+	_ basetypes.ObjectValuable = DefaultsValue{}
+)
+
+// DefaultsOpts is used as an argument to DefaultsBlock and DefaultsAttributes to
+// indicate which resource operations should have a default timeout attribute
+// created and whether supplied descriptions should override default descriptions.
+type DefaultsOpts struct {
+	Create            bool
+	Read              bool
+	Update            bool
+	Delete            bool
+	Plan              bool
+	Import            bool
+	CreateDescription string
+	ReadDescription   string
+	UpdateDescription string
+	DeleteDescription string
+	PlanDescription   string
+	ImportDescription string
+}
+
+// DefaultsBlock returns a schema.Block, typically added to the provider schema as
+// "default_timeouts", containing attributes for each of the resource operations
+// in DefaultsOpts which are set to true. Each attribute is defined as
+// types.StringType and optional. A validator is used to verify that the value
+// assigned to an attribute can be parsed as time.Duration.
+func DefaultsBlock(ctx context.Context, opts DefaultsOpts) schema.Block {
+	return schema.SingleNestedBlock{
+		Attributes: defaultsAttributesMap(opts),
+		CustomType: DefaultsType{
+			ObjectType: types.ObjectType{
+				AttrTypes: defaultsAttrTypesMap(opts),
+			},
+		},
+	}
+}
+
+// DefaultsAttributes returns a schema.SingleNestedAttribute, typically added to
+// the provider schema as "default_timeouts", which contains attributes for each
+// of the resource operations in DefaultsOpts which are set to true. Each attribute
+// is defined as types.StringType and optional. A validator is used to verify that
+// the value assigned to an attribute can be parsed as time.Duration.
+func DefaultsAttributes(ctx context.Context, opts DefaultsOpts) schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Attributes: defaultsAttributesMap(opts),
+		CustomType: DefaultsType{
+			ObjectType: types.ObjectType{
+				AttrTypes: defaultsAttrTypesMap(opts),
+			},
+		},
+		Optional: true,
+	}
+}
+
+// DefaultsType is an attribute type that represents provider default timeouts.
+type DefaultsType struct {
+	basetypes.ObjectType
+}
+
+// String returns a human-readable representation of the type.
+func (t DefaultsType) String() string {
+	return "timeouts.DefaultsType"
+}
+
+// ValueFromObject returns a DefaultsValue given a basetypes.ObjectValue.
+func (t DefaultsType) ValueFromObject(_ context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	value := DefaultsValue{
+		Object: in,
+	}
+
+	return value, nil
+}
+
+// ValueFromTerraform returns a DefaultsValue given a tftypes.Value.
+// DefaultsValue embeds the types.Object value returned from calling ValueFromTerraform
+// on the types.ObjectType embedded in DefaultsType.
+func (t DefaultsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := t.ObjectType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := val.(types.Object)
+	if !ok {
+		return nil, fmt.Errorf("%T cannot be used as types.Object", val)
+	}
+
+	return DefaultsValue{
+		obj,
+	}, err
+}
+
+// ValueType returns the associated DefaultsValue type for debugging.
+func (t DefaultsType) ValueType(context.Context) attr.Value {
+	// It does not need to be a fully valid implementation of the type.
+	return DefaultsValue{}
+}
+
+// Equal returns true if `candidate` is also a DefaultsType and has the same
+// AttributeTypes.
+func (t DefaultsType) Equal(candidate attr.Type) bool {
+	other, ok := candidate.(DefaultsType)
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+// DefaultsValue represents an object containing provider default timeouts for
+// resource operations.
+type DefaultsValue struct {
+	types.Object
+}
+
+// Equal returns true if the DefaultsValue is considered semantically equal
+// (same type and same value) to the attr.Value passed as an argument.
+func (t DefaultsValue) Equal(c attr.Value) bool {
+	other, ok := c.(DefaultsValue)
+
+	if !ok {
+		return false
+	}
+
+	return t.Object.Equal(other.Object)
+}
+
+// ToObjectValue returns the underlying ObjectValue.
+func (v DefaultsValue) ToObjectValue(_ context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	return v.Object, nil
+}
+
+// Type returns a DefaultsType with the same attribute types as `t`.
+func (t DefaultsValue) Type(ctx context.Context) attr.Type {
+	return DefaultsType{
+		types.ObjectType{
+			AttrTypes: t.AttributeTypes(ctx),
+		},
+	}
+}
+
+// Defaults parses each of the configured attributes as time.Duration and returns
+// them as resource timeouts Defaults. The result is typically stored in the
+// ProviderData during provider Configure and then supplied to
+// resource timeouts ContextWithDefaults within resource CRUD functions.
+// Attributes which are not set, null or unknown leave the matching default unset.
+func (t DefaultsValue) Defaults(ctx context.Context) (resourcetimeouts.Defaults, diag.Diagnostics) {
+	return resourcetimeouts.DefaultsFromObject(ctx, t.Object)
+}
+
+func defaultsAttributesMap(opts DefaultsOpts) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{}
+
+	for _, operation := range []struct {
+		name        string
+		enabled     bool
+		description string
+	}{
+		{defaultsAttributeNameCreate, opts.Create, opts.CreateDescription},
+		{defaultsAttributeNameRead, opts.Read, opts.ReadDescription},
+		{defaultsAttributeNameUpdate, opts.Update, opts.UpdateDescription},
+		{defaultsAttributeNameDelete, opts.Delete, opts.DeleteDescription},
+		{defaultsAttributeNamePlan, opts.Plan, opts.PlanDescription},
+		{defaultsAttributeNameImport, opts.Import, opts.ImportDescription},
+	} {
+		if !operation.enabled {
+			continue
+		}
+
+		attribute := schema.StringAttribute{
+			Optional: true,
+			Description: fmt.Sprintf("Default timeout for resource %s operations, used when a resource does not "+
+				"configure its own %s timeout. ", operation.name, operation.name) + descriptionDuration,
+			Validators: []validator.String{
+				validators.PositiveTimeDuration(),
+			},
+		}
+
+		if operation.description != "" {
+			attribute.Description = operation.description
+		}
+
+		attributes[operation.name] = attribute
+	}
+
+	return attributes
+}
+
+func defaultsAttrTypesMap(opts DefaultsOpts) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{}
+
+	for name, enabled := range map[string]bool{
+		defaultsAttributeNameCreate: opts.Create,
+		defaultsAttributeNameRead:   opts.Read,
+		defaultsAttributeNameUpdate: opts.Update,
+		defaultsAttributeNameDelete: opts.Delete,
+		defaultsAttributeNamePlan:   opts.Plan,
+		defaultsAttributeNameImport: opts.Import,
+	} {
+		if enabled {
+			attrTypes[name] = types.StringType
+		}
+	}
+
+	return attrTypes
+}
@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/provider/timeouts"
+	resourcetimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestDefaultsBlock(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		opts     timeouts.DefaultsOpts
+		expected schema.Block
+	}
+	tests := map[string]testCase{
+		"empty-opts": {
+			opts: timeouts.DefaultsOpts{},
+			expected: schema.SingleNestedBlock{
+				CustomType: timeouts.DefaultsType{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{},
+					},
+				},
+				Attributes: map[string]schema.Attribute{},
+			},
+		},
+		"create-import-opts": {
+			opts: timeouts.DefaultsOpts{
+				Create:            true,
+				Import:            true,
+				ImportDescription: "import description",
+			},
+			expected: schema.SingleNestedBlock{
+				CustomType: timeouts.DefaultsType{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"create": types.StringType,
+							"import": types.StringType,
+						},
+					},
+				},
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Optional: true,
+						Description: `Default timeout for resource create operations, used when a resource does not ` +
+							`configure its own create timeout. ` +
+							`A string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) ` +
+							`consisting of numbers and unit suffixes, such as "30s" or "2h45m". Valid time units are ` +
+							`"s" (seconds), "m" (minutes), "h" (hours).`,
+						Validators: []validator.String{
+							validators.PositiveTimeDuration(),
+						},
+					},
+					"import": schema.StringAttribute{
+						Optional:    true,
+						Description: "import description",
+						Validators: []validator.String{
+							validators.PositiveTimeDuration(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			actual := timeouts.DefaultsBlock(context.Background(), test.opts)
+
+			if diff := cmp.Diff(actual, test.expected); diff != "" {
+				t.Errorf("unexpected block difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDefaultsAttributes(t *testing.T) {
+	t.Parallel()
+
+	actual := timeouts.DefaultsAttributes(context.Background(), timeouts.DefaultsOpts{
+		Delete:            true,
+		DeleteDescription: "delete description",
+	})
+
+	expected := schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"delete": schema.StringAttribute{
+				Optional:    true,
+				Description: "delete description",
+				Validators: []validator.String{
+					validators.PositiveTimeDuration(),
+				},
+			},
+		},
+		CustomType: timeouts.DefaultsType{
+			ObjectType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"delete": types.StringType,
+				},
+			},
+		},
+		Optional: true,
+	}
+
+	if diff := cmp.Diff(actual, expected); diff != "" {
+		t.Errorf("unexpected attribute difference: %s", diff)
+	}
+}
+
+func TestDefaultsValueDefaults(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		defaultsValue    timeouts.DefaultsValue
+		expectedDefaults resourcetimeouts.Defaults
+		expectedDiags    diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"all": {
+			defaultsValue: timeouts.DefaultsValue{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+						"read":   types.StringType,
+						"update": types.StringType,
+						"delete": types.StringType,
+						"plan":   types.StringType,
+						"import": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("90m"),
+						"read":   types.StringValue("5m"),
+						"update": types.StringValue("60m"),
+						"delete": types.StringValue("30m"),
+						"plan":   types.StringValue("1m"),
+						"import": types.StringValue("15m"),
+					},
+				),
+			},
+			expectedDefaults: resourcetimeouts.Defaults{
+				Create: 90 * time.Minute,
+				Read:   5 * time.Minute,
+				Update: 60 * time.Minute,
+				Delete: 30 * time.Minute,
+				Plan:   time.Minute,
+				Import: 15 * time.Minute,
+			},
+		},
+		"not-set": {
+			defaultsValue: timeouts.DefaultsValue{
+				Object: types.Object{},
+			},
+			expectedDefaults: resourcetimeouts.Defaults{},
+		},
+		"null-and-unknown": {
+			defaultsValue: timeouts.DefaultsValue{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+						"read":   types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringNull(),
+						"read":   types.StringUnknown(),
+					},
+				),
+			},
+			expectedDefaults: resourcetimeouts.Defaults{},
+		},
+		"not-parseable-as-time-duration": {
+			defaultsValue: timeouts.DefaultsValue{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+						"delete": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("10x"),
+						"delete": types.StringValue("10m"),
+					},
+				),
+			},
+			expectedDefaults: resourcetimeouts.Defaults{
+				Delete: 10 * time.Minute,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`default timeout for "create" cannot be parsed, time: unknown unit "x" in duration "10x"`,
+				),
+			},
+		},
+		"not-greater-than-zero": {
+			defaultsValue: timeouts.DefaultsValue{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+						"read":   types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("-5m"),
+						"read":   types.StringValue("0s"),
+					},
+				),
+			},
+			expectedDefaults: resourcetimeouts.Defaults{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`default timeout for "create" must be greater than zero, got -5m`,
+				),
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`default timeout for "read" must be greater than zero, got 0s`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotDefaults, gotErr := test.defaultsValue.Defaults(context.Background())
+
+			if diff := cmp.Diff(gotDefaults, test.expectedDefaults); diff != "" {
+				t.Errorf("unexpected defaults difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotErr, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
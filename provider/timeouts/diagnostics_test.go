@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/provider/timeouts"
+)
+
+// The summary and detail of the diagnostic are tested with the internal
+// timeoutdiag package.
+func TestDeadlineExceededDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	err := fmt.Errorf("calling API: %w", &timeouts.TimeoutError{
+		Operation: "configure",
+		Timeout:   time.Nanosecond,
+		Source:    timeouts.SourceConfig,
+		Elapsed:   time.Nanosecond,
+	})
+
+	got, ok := timeouts.DeadlineExceededDiagnostic(ctx, err).(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("expected a diagnostic with a path, got %T", got)
+	}
+
+	if expected := path.Root("timeouts").AtName("configure"); !got.Path().Equal(expected) {
+		t.Errorf("expected path %s, got %s", expected, got.Path())
+	}
+
+	if got := timeouts.DeadlineExceededDiagnostic(ctx, errors.New("calling API: not found")); got != nil {
+		t.Errorf("expected no diagnostic, got %v", got)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
+)
+
+const (
+	metaAttributeNameDefaultTimeouts  = "default_timeouts"
+	metaAttributeNameResourceTimeouts = "resource_timeouts"
+)
+
+// MetaSchema returns a metaschema.Schema which allows module authors to set
+// default timeouts for the resources within their module using a provider_meta
+// block. It contains only the attributes returned by MetaAttributes, so providers
+// which already define a provider meta schema should add those attributes to it
+// instead.
+func MetaSchema(ctx context.Context, opts DefaultsOpts) metaschema.Schema {
+	return metaschema.Schema{
+		Attributes: MetaAttributes(ctx, opts),
+	}
+}
+
+// MetaAttributes returns the "default_timeouts" and "resource_timeouts" attributes
+// of a provider meta schema, keyed by name, for merging into the attributes of the
+// provider meta schema. The "default_timeouts" attribute applies to all resource
+// types, while the "resource_timeouts" attribute is a map, keyed by resource type,
+// which takes precedence for the named resource types. Each contains attributes
+// for each of the resource operations in DefaultsOpts which are set to true,
+// defined as types.StringType and optional.
+//
+// The defaults are read within resource CRUD functions by calling the resource
+// timeouts ContextWithProviderMeta function with req.ProviderMeta. As the provider
+// meta schema does not support validators, ContextWithProviderMeta applies the
+// validator of the DefaultsBlock attributes as it reads each value.
+func MetaAttributes(ctx context.Context, opts DefaultsOpts) map[string]metaschema.Attribute {
+	return map[string]metaschema.Attribute{
+		metaAttributeNameDefaultTimeouts: metaschema.SingleNestedAttribute{
+			Attributes:  metaAttributesMap(opts),
+			Description: "Default timeouts for all resources within the module.",
+			Optional:    true,
+		},
+		metaAttributeNameResourceTimeouts: metaschema.MapNestedAttribute{
+			NestedObject: metaschema.NestedAttributeObject{
+				Attributes: metaAttributesMap(opts),
+			},
+			Description: "Default timeouts for resources within the module, keyed by resource type. " +
+				"These take precedence over default_timeouts.",
+			Optional: true,
+		},
+	}
+}
+
+func metaAttributesMap(opts DefaultsOpts) map[string]metaschema.Attribute {
+	attributes := map[string]metaschema.Attribute{}
+
+	for _, operation := range []struct {
+		name        string
+		enabled     bool
+		description string
+	}{
+		{defaultsAttributeNameCreate, opts.Create, opts.CreateDescription},
+		{defaultsAttributeNameRead, opts.Read, opts.ReadDescription},
+		{defaultsAttributeNameUpdate, opts.Update, opts.UpdateDescription},
+		{defaultsAttributeNameDelete, opts.Delete, opts.DeleteDescription},
+		{defaultsAttributeNamePlan, opts.Plan, opts.PlanDescription},
+		{defaultsAttributeNameImport, opts.Import, opts.ImportDescription},
+	} {
+		if !operation.enabled {
+			continue
+		}
+
+		attribute := metaschema.StringAttribute{
+			Optional: true,
+			Description: fmt.Sprintf("Default timeout for resource %s operations within the module, used when "+
+				"a resource does not configure its own %s timeout. ", operation.name, operation.name) + descriptionDuration,
+		}
+
+		if operation.description != "" {
+			attribute.Description = operation.description
+		}
+
+		attributes[operation.name] = attribute
+	}
+
+	return attributes
+}
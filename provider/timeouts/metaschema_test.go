@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/provider/timeouts"
+)
+
+func TestMetaSchema(t *testing.T) {
+	t.Parallel()
+
+	actual := timeouts.MetaSchema(context.Background(), timeouts.DefaultsOpts{
+		Create:            true,
+		CreateDescription: "create description",
+	})
+
+	expected := metaschema.Schema{
+		Attributes: map[string]metaschema.Attribute{
+			"default_timeouts": metaschema.SingleNestedAttribute{
+				Attributes: map[string]metaschema.Attribute{
+					"create": metaschema.StringAttribute{
+						Optional:    true,
+						Description: "create description",
+					},
+				},
+				Description: "Default timeouts for all resources within the module.",
+				Optional:    true,
+			},
+			"resource_timeouts": metaschema.MapNestedAttribute{
+				NestedObject: metaschema.NestedAttributeObject{
+					Attributes: map[string]metaschema.Attribute{
+						"create": metaschema.StringAttribute{
+							Optional:    true,
+							Description: "create description",
+						},
+					},
+				},
+				Description: "Default timeouts for resources within the module, keyed by resource type. " +
+					"These take precedence over default_timeouts.",
+				Optional: true,
+			},
+		},
+	}
+
+	if diff := cmp.Diff(actual, expected); diff != "" {
+		t.Errorf("unexpected schema difference: %s", diff)
+	}
+
+	if diags := actual.ValidateImplementation(context.Background()); diags.HasError() {
+		t.Errorf("unexpected schema validation diagnostics: %v", diags)
+	}
+}
+
+func TestMetaAttributes(t *testing.T) {
+	t.Parallel()
+
+	providerMetaSchema := metaschema.Schema{
+		Attributes: map[string]metaschema.Attribute{
+			"module_name": metaschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	for name, attribute := range timeouts.MetaAttributes(context.Background(), timeouts.DefaultsOpts{Create: true}) {
+		providerMetaSchema.Attributes[name] = attribute
+	}
+
+	expected := timeouts.MetaSchema(context.Background(), timeouts.DefaultsOpts{Create: true})
+
+	for name, attribute := range expected.Attributes {
+		if diff := cmp.Diff(providerMetaSchema.Attributes[name], attribute); diff != "" {
+			t.Errorf("unexpected %s attribute difference: %s", name, diff)
+		}
+	}
+
+	if diags := providerMetaSchema.ValidateImplementation(context.Background()); diags.HasError() {
+		t.Errorf("unexpected schema validation diagnostics: %v", diags)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolution"
+)
+
+// Resolution describes the timeout resolved for an operation by a Value accessor,
+// including where it was resolved from, so that callers can distinguish a
+// configured timeout from a default or an environment variable override.
+type Resolution = resolution.Resolution
+
+// ResolveConfigure returns the Resolution of the timeout returned by Configure, describing
+// the effective timeout along with where it was resolved from, the configured
+// value and the path of the "configure" attribute. If any diagnostics are
+// generated they are returned along with a Resolution of the supplied default
+// timeout.
+func (t Value) ResolveConfigure(ctx context.Context, defaultTimeout time.Duration) (Resolution, diag.Diagnostics) {
+	return t.resolveTimeout(ctx, attributeNameConfigure, defaultTimeout, path.Root("timeouts"))
+}
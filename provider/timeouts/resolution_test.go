@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/provider/timeouts"
+)
+
+func TestTimeoutsValueResolveConfigure(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		timeoutsValue      timeouts.Value
+		expectedResolution timeouts.Resolution
+		expectedDiags      diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"configure": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"configure": types.StringType,
+					},
+					map[string]attr.Value{
+						"configure": types.StringValue("10m"),
+					},
+				),
+			},
+			expectedResolution: timeouts.Resolution{
+				Operation: "configure",
+				Timeout:   10 * time.Minute,
+				Source:    timeouts.SourceConfig,
+				Raw:       "10m",
+				Path:      path.Root("timeouts").AtName("configure"),
+			},
+		},
+		"configure-relative": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"configure": types.StringType,
+					},
+					map[string]attr.Value{
+						"configure": types.StringValue("2x"),
+					},
+				),
+			},
+			expectedResolution: timeouts.Resolution{
+				Operation: "configure",
+				Timeout:   40 * time.Minute,
+				Source:    timeouts.SourceConfig,
+				Raw:       "2x",
+				Path:      path.Root("timeouts").AtName("configure"),
+			},
+		},
+		"configure-null": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"configure": types.StringType,
+					},
+					map[string]attr.Value{
+						"configure": types.StringNull(),
+					},
+				),
+			},
+			expectedResolution: timeouts.Resolution{
+				Operation: "configure",
+				Timeout:   20 * time.Minute,
+				Source:    timeouts.SourceDefault,
+				Path:      path.Root("timeouts").AtName("configure"),
+			},
+		},
+		"configure-not-parseable-as-time-duration": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"configure": types.StringType,
+					},
+					map[string]attr.Value{
+						"configure": types.StringValue("10y"),
+					},
+				),
+			},
+			expectedResolution: timeouts.Resolution{
+				Operation: "configure",
+				Timeout:   20 * time.Minute,
+				Source:    timeouts.SourceDefault,
+				Raw:       "10y",
+				Path:      path.Root("timeouts").AtName("configure"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`timeout for "configure" cannot be parsed, time: unknown unit "y" in duration "10y"`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResolution, gotErr := test.timeoutsValue.ResolveConfigure(context.Background(), 20*time.Minute)
+
+			if diff := cmp.Diff(gotResolution, test.expectedResolution); diff != "" {
+				t.Errorf("unexpected resolution difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotErr, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutsvalue"
+)
+
+var (
+	_ basetypes.ObjectTypable  = Type{}
+	_ basetypes.ObjectValuable = Value{}
+	_ Timeouts                 = Value{}
+)
+
+// core implements Type and Value for the "configure" operation.
+var core = timeoutsvalue.Core[Type, Value]{
+	Operations: []string{attributeNameConfigure},
+}
+
+// Timeouts is implemented by the Value of every timeouts package, so that helpers
+// can accept the Value of any package.
+type Timeouts = timeoutsvalue.Timeouts
+
+// Type is an attribute type that represents timeouts.
+type Type struct {
+	basetypes.ObjectType
+}
+
+// String returns a human-readable representation of the type.
+func (t Type) String() string {
+	return "timeouts.Type"
+}
+
+// ValueFromObject returns a Value given a basetypes.ObjectValue.
+func (t Type) ValueFromObject(_ context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	return core.ValueFromObject(in), nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+// Value embeds the types.Object value returned from calling ValueFromTerraform on the
+// types.ObjectType embedded in Type.
+func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return core.ValueFromTerraform(ctx, t, in)
+}
+
+// ValueType returns the associated Value type for debugging.
+func (t Type) ValueType(context.Context) attr.Value {
+	// It does not need to be a fully valid implementation of the type.
+	return Value{}
+}
+
+// Equal returns true if `candidate` is also a Type and has the same
+// AttributeTypes.
+func (t Type) Equal(candidate attr.Type) bool {
+	return core.TypeEqual(t, candidate)
+}
+
+// Value represents an object containing values to be used as time.Duration for timeouts.
+type Value struct {
+	types.Object
+}
+
+// Equal returns true if the Value is considered semantically equal
+// (same type and same value) to the attr.Value passed as an argument.
+func (t Value) Equal(c attr.Value) bool {
+	return core.ValueEqual(t, c)
+}
+
+// ToObjectValue returns the underlying ObjectValue.
+func (v Value) ToObjectValue(_ context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	return v.Object, nil
+}
+
+// Type returns a Type with the same attribute types as `t`.
+func (t Value) Type(ctx context.Context) attr.Type {
+	return core.ValueType(ctx, t)
+}
+
+// Configure attempts to retrieve the "configure" attribute and parse it as time.Duration.
+// If any diagnostics are generated they are returned along with the supplied default timeout.
+func (t Value) Configure(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.getTimeout(ctx, attributeNameConfigure, defaultTimeout)
+}
+
+// Operations returns the names of the operations whose timeouts can be configured,
+// which is only "configure".
+func (t Value) Operations() []string {
+	return append([]string(nil), core.Operations...)
+}
+
+// OperationTimeout returns the timeout for the named operation, as returned by the
+// accessor for the operation, such as Configure for "configure". The supplied default timeout is
+// returned for an operation which is not supported. If any diagnostics are generated
+// they are returned along with the supplied default timeout.
+func (t Value) OperationTimeout(ctx context.Context, operation string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.getTimeout(ctx, operation, defaultTimeout)
+}
+
+// ResolveOperation returns the Resolution of the timeout returned by
+// OperationTimeout, for timeouts at the root of the schema. If any diagnostics are
+// generated they are returned along with a Resolution of the supplied default
+// timeout.
+func (t Value) ResolveOperation(ctx context.Context, operation string, defaultTimeout time.Duration) (Resolution, diag.Diagnostics) {
+	return t.ResolveOperationAtPath(ctx, operation, defaultTimeout, path.Root("timeouts"))
+}
+
+// ResolveOperationAtPath is the same as ResolveOperation, but the Path of the
+// Resolution is within the timeouts attribute or block at timeoutsPath.
+func (t Value) ResolveOperationAtPath(ctx context.Context, operation string, defaultTimeout time.Duration, timeoutsPath path.Path) (Resolution, diag.Diagnostics) {
+	return t.resolveTimeout(ctx, operation, defaultTimeout, timeoutsPath)
+}
+
+func (t Value) getTimeout(ctx context.Context, timeoutName string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return core.Timeout(ctx, t, timeoutName, defaultTimeout)
+}
+
+// resolveTimeout returns the timeout for the named operation along with where it
+// was resolved from, with the Path of the Resolution within timeoutsPath.
+func (t Value) resolveTimeout(ctx context.Context, timeoutName string, defaultTimeout time.Duration, timeoutsPath path.Path) (Resolution, diag.Diagnostics) {
+	return core.Resolve(ctx, t, timeoutName, defaultTimeout, timeoutsPath)
+}
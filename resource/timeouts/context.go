@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+)
+
+// CreateContext returns a copy of ctx which is cancelled after the timeout returned by
+// Create, or at the "deadline" attribute if earlier, along with its cancel function,
+// which should be deferred by the caller. The cause of the cancellation, returned by
+// context.Cause, is a *TimeoutError for the "create" timeout. No deadline is applied if
+// the timeout is NoTimeout and no "deadline" is set. If any diagnostics are generated
+// they are returned along with a context derived from the supplied default timeout.
+func (t Value) CreateContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return t.getContext(ctx, attributeNameCreate, defaultTimeout)
+}
+
+// ReadContext returns a copy of ctx which is cancelled after the timeout returned by
+// Read, or at the "deadline" attribute if earlier, along with its cancel function,
+// which should be deferred by the caller. The cause of the cancellation, returned by
+// context.Cause, is a *TimeoutError for the "read" timeout. No deadline is applied if
+// the timeout is NoTimeout and no "deadline" is set. If any diagnostics are generated
+// they are returned along with a context derived from the supplied default timeout.
+func (t Value) ReadContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return t.getContext(ctx, attributeNameRead, defaultTimeout)
+}
+
+// UpdateContext returns a copy of ctx which is cancelled after the timeout returned by
+// Update, or at the "deadline" attribute if earlier, along with its cancel function,
+// which should be deferred by the caller. The cause of the cancellation, returned by
+// context.Cause, is a *TimeoutError for the "update" timeout. No deadline is applied if
+// the timeout is NoTimeout and no "deadline" is set. If any diagnostics are generated
+// they are returned along with a context derived from the supplied default timeout.
+func (t Value) UpdateContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return t.getContext(ctx, attributeNameUpdate, defaultTimeout)
+}
+
+// DeleteContext returns a copy of ctx which is cancelled after the timeout returned by
+// Delete, or at the "deadline" attribute if earlier, along with its cancel function,
+// which should be deferred by the caller. The cause of the cancellation, returned by
+// context.Cause, is a *TimeoutError for the "delete" timeout. No deadline is applied if
+// the timeout is NoTimeout and no "deadline" is set. If any diagnostics are generated
+// they are returned along with a context derived from the supplied default timeout.
+func (t Value) DeleteContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return t.getContext(ctx, attributeNameDelete, defaultTimeout)
+}
+
+// PlanContext returns a copy of ctx which is cancelled after the timeout returned by
+// Plan, or at the "deadline" attribute if earlier, along with its cancel function,
+// which should be deferred by the caller. The cause of the cancellation, returned by
+// context.Cause, is a *TimeoutError for the "plan" timeout. No deadline is applied if
+// the timeout is NoTimeout and no "deadline" is set. If any diagnostics are generated
+// they are returned along with a context derived from the supplied default timeout.
+func (t Value) PlanContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return t.getContext(ctx, attributeNamePlan, defaultTimeout)
+}
+
+// ImportContext returns a copy of ctx which is cancelled after the timeout returned by
+// Import, along with its cancel function, which should be deferred by the caller.
+// The cause of the cancellation, returned by context.Cause, is a *TimeoutError for
+// the "import" timeout. If any diagnostics are generated they are returned along
+// with a context derived from the supplied default timeout.
+func ImportContext(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	r, diags := ResolveImport(ctx, defaultTimeout)
+
+	ctx, cancel := timeoutctx.WithTimeout(ctx, operationNameImport, r.Timeout, r.Source)
+
+	return ctx, cancel, diags
+}
+
+func (t Value) getContext(ctx context.Context, timeoutName string, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	r, diags := t.resolveTimeout(ctx, timeoutName, defaultTimeout, path.Root("timeouts"))
+
+	deadline, ok, d := t.absoluteDeadline()
+	diags.Append(d...)
+
+	if ok && (r.Timeout == NoTimeout || deadline.Before(time.Now().Add(r.Timeout))) {
+		ctx, cancel := timeoutctx.WithDeadline(ctx, timeoutName, deadline, r.Timeout)
+
+		return ctx, cancel, diags
+	}
+
+	ctx, cancel := timeoutctx.WithTimeout(ctx, timeoutName, r.Timeout, r.Source)
+
+	return ctx, cancel, diags
+}
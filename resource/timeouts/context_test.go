@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestTimeoutsValueCreateContext(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		timeoutsValue timeouts.Value
+		expectedCause string
+	}
+	tests := map[string]testCase{
+		"timeout": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("1ns"),
+					},
+				),
+			},
+			expectedCause: "create timeout of 1ns (config) exceeded after ",
+		},
+		"deadline": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create":   types.StringType,
+						"deadline": types.StringType,
+					},
+					map[string]attr.Value{
+						"create":   types.StringValue("10m"),
+						"deadline": types.StringValue("2006-01-02T15:04:05Z"),
+					},
+				),
+			},
+			expectedCause: "create deadline of 2006-01-02T15:04:05Z exceeded after ",
+		},
+		"deadline-none": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create":   types.StringType,
+						"deadline": types.StringType,
+					},
+					map[string]attr.Value{
+						"create":   types.StringValue("none"),
+						"deadline": types.StringValue("2006-01-02T15:04:05Z"),
+					},
+				),
+			},
+			expectedCause: "create deadline of 2006-01-02T15:04:05Z exceeded after ",
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel, diags := test.timeoutsValue.CreateContext(context.Background(), 20*time.Minute)
+			defer cancel()
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			<-ctx.Done()
+
+			cause := context.Cause(ctx)
+
+			if !errors.Is(cause, context.DeadlineExceeded) {
+				t.Errorf("expected cause to wrap context.DeadlineExceeded, got %v", cause)
+			}
+
+			if got := cause.Error(); !strings.HasPrefix(got, test.expectedCause) {
+				t.Errorf("expected cause with prefix %q, got %q", test.expectedCause, got)
+			}
+		})
+	}
+}
+
+func TestTimeoutsValueCreateContextNone(t *testing.T) {
+	t.Parallel()
+
+	timeoutsValue := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringValue("none"),
+			},
+		),
+	}
+
+	ctx, cancel, diags := timeoutsValue.CreateContext(context.Background(), 20*time.Minute)
+	defer cancel()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		t.Errorf("expected no deadline, got %s", deadline)
+	}
+}
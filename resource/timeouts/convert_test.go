@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	datasourcetimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutsvalue"
+	listtimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/list/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func dataSourceValue(read attr.Value) datasourcetimeouts.Value {
+	return datasourcetimeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"read": types.StringType,
+			},
+			map[string]attr.Value{
+				"read": read,
+			},
+		),
+	}
+}
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		from          timeouts.Timeouts
+		operations    map[timeouts.Operation]string
+		expected      timeouts.Value
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"datasource-read": {
+			from: dataSourceValue(types.StringValue("2x")),
+			operations: map[timeouts.Operation]string{
+				timeouts.OperationRead: "read",
+			},
+			expected: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"read": timeoutsvalue.ConvertedStringType{},
+					},
+					map[string]attr.Value{
+						"read": timeoutsvalue.ConvertedString{StringValue: types.StringValue("2x")},
+					},
+				),
+			},
+		},
+		"datasource-read-null": {
+			from: dataSourceValue(types.StringNull()),
+			operations: map[timeouts.Operation]string{
+				timeouts.OperationRead: "read",
+			},
+			expected: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"read": timeoutsvalue.ConvertedStringType{},
+					},
+					map[string]attr.Value{
+						"read": timeoutsvalue.ConvertedString{StringValue: types.StringNull()},
+					},
+				),
+			},
+		},
+		"datasource-null": {
+			from: datasourcetimeouts.Value{
+				Object: types.ObjectNull(map[string]attr.Type{
+					"read": types.StringType,
+				}),
+			},
+			operations: map[timeouts.Operation]string{
+				timeouts.OperationRead: "read",
+			},
+			expected: timeouts.Value{
+				Object: types.ObjectNull(map[string]attr.Type{
+					"read": timeoutsvalue.ConvertedStringType{},
+				}),
+			},
+		},
+		"datasource-attribute-not-in-schema": {
+			from: datasourcetimeouts.Value{
+				Object: types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}),
+			},
+			operations: map[timeouts.Operation]string{
+				timeouts.OperationRead: "read",
+			},
+			expected: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"read": timeoutsvalue.ConvertedStringType{},
+					},
+					map[string]attr.Value{
+						"read": timeoutsvalue.ConvertedString{StringValue: types.StringNull()},
+					},
+				),
+			},
+		},
+		"unsupported-operation": {
+			from: dataSourceValue(types.StringValue("10m")),
+			operations: map[timeouts.Operation]string{
+				"open": "read",
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeouts Cannot Be Converted",
+					`operation "open" is not supported, expected one of ["create" "read" "update" "delete" "plan"]`,
+				),
+			},
+		},
+		"unsupported-from-operation": {
+			from: dataSourceValue(types.StringValue("10m")),
+			operations: map[timeouts.Operation]string{
+				timeouts.OperationRead: "list",
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeouts Cannot Be Converted",
+					`operation "list" of timeouts.Value is not supported, expected one of ["read"]`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := timeouts.Convert(context.Background(), test.from, test.operations)
+
+			if diff := cmp.Diff(gotDiags, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected diags difference: %s", diff)
+			}
+
+			if !got.Equal(test.expected) {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestFromDataSource(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		from            datasourcetimeouts.Value
+		expectedTimeout time.Duration
+		expectedDiags   diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"configured": {
+			from:            dataSourceValue(types.StringValue("30m")),
+			expectedTimeout: 30 * time.Minute,
+		},
+		"relative": {
+			from:            dataSourceValue(types.StringValue("2x")),
+			expectedTimeout: 40 * time.Minute,
+		},
+		"not-configured": {
+			from:            dataSourceValue(types.StringNull()),
+			expectedTimeout: 20 * time.Minute,
+		},
+		"invalid": {
+			from:            dataSourceValue(types.StringValue("10y")),
+			expectedTimeout: 20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`timeout for "read" cannot be parsed, time: unknown unit "y" in duration "10y"`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, diags := timeouts.FromDataSource(context.Background(), test.from)
+			if diags.HasError() {
+				t.Fatalf("unexpected diags: %v", diags)
+			}
+
+			gotTimeout, gotDiags := value.Read(context.Background(), 20*time.Minute)
+
+			if diff := cmp.Diff(gotTimeout, test.expectedTimeout); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+
+			// The diagnostics match those of the data source accessor.
+			_, fromDiags := test.from.Read(context.Background(), 20*time.Minute)
+
+			if diff := cmp.Diff(gotDiags, fromDiags); diff != "" {
+				t.Errorf("unexpected diags difference from data source: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected diags difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromList(t *testing.T) {
+	t.Parallel()
+
+	from := listtimeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"list": types.StringType,
+			},
+			map[string]attr.Value{
+				"list": types.StringValue("15m"),
+			},
+		),
+	}
+
+	value, diags := timeouts.FromList(context.Background(), from)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	got, diags := value.Read(context.Background(), 20*time.Minute)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, 15*time.Minute); diff != "" {
+		t.Errorf("unexpected timeout difference: %s", diff)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/logging"
+)
+
+// CreateDeadline returns the earlier of the current time plus the timeout returned by
+// Create, and the "deadline" attribute parsed as an RFC 3339 timestamp, for use with
+// context.WithDeadline. If any diagnostics are generated they are returned along with
+// the deadline derived from the timeout alone.
+func (t Value) CreateDeadline(ctx context.Context, defaultTimeout time.Duration) (time.Time, diag.Diagnostics) {
+	return t.getDeadline(ctx, attributeNameCreate, defaultTimeout)
+}
+
+// ReadDeadline returns the earlier of the current time plus the timeout returned by
+// Read, and the "deadline" attribute parsed as an RFC 3339 timestamp, for use with
+// context.WithDeadline. If any diagnostics are generated they are returned along with
+// the deadline derived from the timeout alone.
+func (t Value) ReadDeadline(ctx context.Context, defaultTimeout time.Duration) (time.Time, diag.Diagnostics) {
+	return t.getDeadline(ctx, attributeNameRead, defaultTimeout)
+}
+
+// UpdateDeadline returns the earlier of the current time plus the timeout returned by
+// Update, and the "deadline" attribute parsed as an RFC 3339 timestamp, for use with
+// context.WithDeadline. If any diagnostics are generated they are returned along with
+// the deadline derived from the timeout alone.
+func (t Value) UpdateDeadline(ctx context.Context, defaultTimeout time.Duration) (time.Time, diag.Diagnostics) {
+	return t.getDeadline(ctx, attributeNameUpdate, defaultTimeout)
+}
+
+// DeleteDeadline returns the earlier of the current time plus the timeout returned by
+// Delete, and the "deadline" attribute parsed as an RFC 3339 timestamp, for use with
+// context.WithDeadline. If any diagnostics are generated they are returned along with
+// the deadline derived from the timeout alone.
+func (t Value) DeleteDeadline(ctx context.Context, defaultTimeout time.Duration) (time.Time, diag.Diagnostics) {
+	return t.getDeadline(ctx, attributeNameDelete, defaultTimeout)
+}
+
+// PlanDeadline returns the earlier of the current time plus the timeout returned by
+// Plan, and the "deadline" attribute parsed as an RFC 3339 timestamp, for use with
+// context.WithDeadline. If any diagnostics are generated they are returned along with
+// the deadline derived from the timeout alone.
+func (t Value) PlanDeadline(ctx context.Context, defaultTimeout time.Duration) (time.Time, diag.Diagnostics) {
+	return t.getDeadline(ctx, attributeNamePlan, defaultTimeout)
+}
+
+func (t Value) getDeadline(ctx context.Context, timeoutName string, defaultTimeout time.Duration) (time.Time, diag.Diagnostics) {
+	timeout, diags := t.getTimeout(ctx, timeoutName, defaultTimeout)
+
+	deadline := time.Now().Add(timeout)
+
+	absolute, ok, d := t.absoluteDeadline()
+	diags.Append(d...)
+
+	if !ok || !absolute.Before(deadline) {
+		return deadline, diags
+	}
+
+	logging.Debug(ctx, timeoutName+" timeout exceeds configured deadline, using deadline", map[string]interface{}{
+		logging.KeyOperation: timeoutName,
+		logging.KeyDeadline:  absolute.Format(time.RFC3339),
+	})
+
+	return absolute, diags
+}
+
+// absoluteDeadline returns the "deadline" attribute parsed as an RFC 3339 timestamp,
+// and whether it is set.
+func (t Value) absoluteDeadline() (time.Time, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, ok := t.Object.Attributes()[attributeNameDeadline]
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false, diags
+	}
+
+	// No type assertion check is required as the schema guarantees that the object attributes
+	// are types.String.
+	//nolint:forcetypeassert
+	deadline, err := time.Parse(time.RFC3339, value.(types.String).ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(
+			"Deadline Cannot Be Parsed",
+			fmt.Sprintf("deadline cannot be parsed, %s", err),
+		))
+
+		return time.Time{}, false, diags
+	}
+
+	return deadline, true, diags
+}
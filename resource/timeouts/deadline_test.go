@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestTimeoutsValueCreateDeadline(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		timeoutsValue timeouts.Value
+		// expectedDeadline is compared exactly when set, otherwise the deadline
+		// is expected to be the current time plus expectedTimeout.
+		expectedDeadline time.Time
+		expectedTimeout  time.Duration
+		expectedDiags    diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"deadline-not-set": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("10m"),
+					},
+				),
+			},
+			expectedTimeout: 10 * time.Minute,
+		},
+		"deadline-null": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create":   types.StringType,
+						"deadline": types.StringType,
+					},
+					map[string]attr.Value{
+						"create":   types.StringValue("10m"),
+						"deadline": types.StringNull(),
+					},
+				),
+			},
+			expectedTimeout: 10 * time.Minute,
+		},
+		"deadline-before-timeout": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create":   types.StringType,
+						"deadline": types.StringType,
+					},
+					map[string]attr.Value{
+						"create":   types.StringValue("10m"),
+						"deadline": types.StringValue("2006-01-02T15:04:05Z"),
+					},
+				),
+			},
+			expectedDeadline: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"deadline-after-timeout": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create":   types.StringType,
+						"deadline": types.StringType,
+					},
+					map[string]attr.Value{
+						"create":   types.StringValue("10m"),
+						"deadline": types.StringValue("9999-01-02T15:04:05Z"),
+					},
+				),
+			},
+			expectedTimeout: 10 * time.Minute,
+		},
+		"deadline-not-parseable": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"deadline": types.StringType,
+					},
+					map[string]attr.Value{
+						"deadline": types.StringValue("2006-01-02"),
+					},
+				),
+			},
+			expectedTimeout: 20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Deadline Cannot Be Parsed",
+					`deadline cannot be parsed, parsing time "2006-01-02" as "2006-01-02T15:04:05Z07:00": cannot parse "" as "T"`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			before := time.Now()
+
+			gotDeadline, gotErr := test.timeoutsValue.CreateDeadline(context.Background(), 20*time.Minute)
+
+			after := time.Now()
+
+			if !test.expectedDeadline.IsZero() {
+				if !gotDeadline.Equal(test.expectedDeadline) {
+					t.Errorf("expected deadline %s, got %s", test.expectedDeadline, gotDeadline)
+				}
+			} else if gotDeadline.Before(before.Add(test.expectedTimeout)) || gotDeadline.After(after.Add(test.expectedTimeout)) {
+				t.Errorf("expected deadline %s from now, got %s", test.expectedTimeout, gotDeadline)
+			}
+
+			if diff := cmp.Diff(gotErr, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/explain"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/validators"
+)
+
+// defaultsContextKey is the context key under which Defaults are stored.
+type defaultsContextKey struct{}
+
+// Defaults contains default timeouts, typically configured once at the provider
+// level, for each resource operation. When a timeout has not been set in the
+// resource configuration, the Value accessors use the matching default in
+// preference to the default timeout supplied to the accessor. A zero duration
+// indicates that no default has been set for that operation.
+type Defaults struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+	Plan   time.Duration
+	Import time.Duration
+}
+
+// ContextWithDefaults returns a copy of ctx containing the supplied Defaults.
+// The Value accessors, and Import, consult these Defaults when a timeout has
+// not been set in configuration. Providers typically resolve Defaults within
+// the provider Configure function, pass them to resources via ProviderData, and
+// call ContextWithDefaults at the start of each CRUD function.
+func ContextWithDefaults(ctx context.Context, defaults Defaults) context.Context {
+	return context.WithValue(ctx, defaultsContextKey{}, defaults)
+}
+
+// DefaultsFromContext returns the Defaults stored in ctx by ContextWithDefaults.
+// An empty Defaults is returned if none have been stored.
+func DefaultsFromContext(ctx context.Context) Defaults {
+	defaults, _ := ctx.Value(defaultsContextKey{}).(Defaults)
+
+	return defaults
+}
+
+// DefaultsFromObject parses each of the string attributes of the supplied object,
+// named after resource operations, as time.Duration and returns them as Defaults.
+// Attributes which are not set, null or unknown leave the matching default unset.
+// An error diagnostic is returned for a duration which is not greater than zero,
+// as a zero default is treated as unset. If any diagnostics are generated, the
+// affected defaults are left unset.
+func DefaultsFromObject(ctx context.Context, in basetypes.ObjectValue) (Defaults, diag.Diagnostics) {
+	return defaultsFromObject(ctx, in, path.Empty())
+}
+
+// defaultsFromObject is the same as DefaultsFromObject, except that a non-empty
+// attributesPath applies the validator of the provider default timeout attributes
+// to each attribute, reporting invalid values at the attribute within
+// attributesPath. It is used for provider_meta, whose schema does not support
+// validators.
+func defaultsFromObject(ctx context.Context, in basetypes.ObjectValue, attributesPath path.Path) (Defaults, diag.Diagnostics) {
+	var defaults Defaults
+	var diags diag.Diagnostics
+
+	for _, operation := range []struct {
+		name   string
+		target *time.Duration
+	}{
+		{attributeNameCreate, &defaults.Create},
+		{attributeNameRead, &defaults.Read},
+		{attributeNameUpdate, &defaults.Update},
+		{attributeNameDelete, &defaults.Delete},
+		{attributeNamePlan, &defaults.Plan},
+		{operationNameImport, &defaults.Import},
+	} {
+		value, ok := in.Attributes()[operation.name]
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		str, ok := value.(types.String)
+		if !ok {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Timeout Cannot Be Parsed",
+				fmt.Sprintf("default timeout for %q cannot be parsed, %T is not a string", operation.name, value),
+			))
+
+			continue
+		}
+
+		if !attributesPath.Equal(path.Empty()) {
+			resp := &validator.StringResponse{}
+
+			validators.PositiveTimeDuration().ValidateString(ctx, validator.StringRequest{
+				Path:        attributesPath.AtName(operation.name),
+				ConfigValue: str,
+			}, resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				continue
+			}
+		}
+
+		timeout, err := time.ParseDuration(str.ValueString())
+		if err != nil {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Timeout Cannot Be Parsed",
+				fmt.Sprintf("default timeout for %q cannot be parsed, %s", operation.name, err),
+			))
+
+			continue
+		}
+
+		if timeout <= 0 {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Timeout Cannot Be Parsed",
+				fmt.Sprintf("default timeout for %q must be greater than zero, got %s", operation.name, str.ValueString()),
+			))
+
+			continue
+		}
+
+		*operation.target = timeout
+	}
+
+	return defaults, diags
+}
+
+// merge returns a copy of d in which any unset defaults are taken from fallback.
+func (d Defaults) merge(fallback Defaults) Defaults {
+	for _, operation := range []struct {
+		target   *time.Duration
+		fallback time.Duration
+	}{
+		{&d.Create, fallback.Create},
+		{&d.Read, fallback.Read},
+		{&d.Update, fallback.Update},
+		{&d.Delete, fallback.Delete},
+		{&d.Plan, fallback.Plan},
+		{&d.Import, fallback.Import},
+	} {
+		if *operation.target == 0 {
+			*operation.target = operation.fallback
+		}
+	}
+
+	return d
+}
+
+// get returns the default for the named operation and whether it has been set.
+func (d Defaults) get(name string) (time.Duration, bool) {
+	var timeout time.Duration
+
+	switch name {
+	case attributeNameCreate:
+		timeout = d.Create
+	case attributeNameRead:
+		timeout = d.Read
+	case attributeNameUpdate:
+		timeout = d.Update
+	case attributeNameDelete:
+		timeout = d.Delete
+	case attributeNamePlan:
+		timeout = d.Plan
+	case operationNameImport:
+		timeout = d.Import
+	}
+
+	return timeout, timeout != 0
+}
+
+// providerDefault returns the default for the named operation from any module
+// defaults stored in ctx by ContextWithProviderMeta, followed by any provider
+// defaults stored in ctx by ContextWithDefaults, otherwise the supplied default
+// timeout, along with the Source of the returned default.
+func providerDefault(ctx context.Context, name string, defaultTimeout time.Duration) (time.Duration, timeoutctx.Source) {
+	if timeout, ok := moduleDefaultsFromContext(ctx).get(name); ok {
+		logging.Trace(ctx, name+" timeout provider_meta default found, using in place of provided default", map[string]interface{}{
+			logging.KeyOperation: name,
+			logging.KeyTimeout:   timeout.String(),
+		})
+		explain.Record(ctx, "provider_meta default %s replaces default %s", duration.String(timeout), duration.String(defaultTimeout))
+
+		return timeout, timeoutctx.SourceModuleDefault
+	}
+
+	if timeout, ok := DefaultsFromContext(ctx).get(name); ok {
+		logging.Trace(ctx, name+" timeout provider default found, using in place of provided default", map[string]interface{}{
+			logging.KeyOperation: name,
+			logging.KeyTimeout:   timeout.String(),
+		})
+		explain.Record(ctx, "provider default %s replaces default %s", duration.String(timeout), duration.String(defaultTimeout))
+
+		return timeout, timeoutctx.SourceProviderDefault
+	}
+
+	explain.Record(ctx, "default %s", duration.String(defaultTimeout))
+
+	return defaultTimeout, timeoutctx.SourceDefault
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestTimeoutsValueCreateWithDefaults(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		timeoutsValue   timeouts.Value
+		defaults        timeouts.Defaults
+		expectedTimeout time.Duration
+		expectedDiags   diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"config-overrides-provider-default": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("10m"),
+					},
+				),
+			},
+			defaults: timeouts.Defaults{
+				Create: 90 * time.Minute,
+			},
+			expectedTimeout: 10 * time.Minute,
+		},
+		"create-null-uses-provider-default": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringNull(),
+					},
+				),
+			},
+			defaults: timeouts.Defaults{
+				Create: 90 * time.Minute,
+			},
+			expectedTimeout: 90 * time.Minute,
+		},
+		"create-not-set-uses-provider-default": {
+			timeoutsValue: timeouts.Value{
+				Object: types.Object{},
+			},
+			defaults: timeouts.Defaults{
+				Create: 90 * time.Minute,
+			},
+			expectedTimeout: 90 * time.Minute,
+		},
+		"provider-default-not-set": {
+			timeoutsValue: timeouts.Value{
+				Object: types.Object{},
+			},
+			defaults: timeouts.Defaults{
+				Read: 90 * time.Minute,
+			},
+			expectedTimeout: 20 * time.Minute,
+		},
+		"create-not-parseable-uses-provider-default": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("10y"),
+					},
+				),
+			},
+			defaults: timeouts.Defaults{
+				Create: 90 * time.Minute,
+			},
+			expectedTimeout: 90 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`timeout for "create" cannot be parsed, time: unknown unit "y" in duration "10y"`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := timeouts.ContextWithDefaults(context.Background(), test.defaults)
+
+			gotTimeout, gotErr := test.timeoutsValue.Create(ctx, 20*time.Minute)
+
+			if diff := cmp.Diff(gotTimeout, test.expectedTimeout); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotErr, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDefaultsFromContext(t *testing.T) {
+	t.Parallel()
+
+	if diff := cmp.Diff(timeouts.DefaultsFromContext(context.Background()), timeouts.Defaults{}); diff != "" {
+		t.Errorf("unexpected defaults difference: %s", diff)
+	}
+
+	expected := timeouts.Defaults{
+		Create: time.Hour,
+		Import: time.Minute,
+	}
+
+	ctx := timeouts.ContextWithDefaults(context.Background(), expected)
+
+	if diff := cmp.Diff(timeouts.DefaultsFromContext(ctx), expected); diff != "" {
+		t.Errorf("unexpected defaults difference: %s", diff)
+	}
+}
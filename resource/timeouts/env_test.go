@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+//nolint:paralleltest // t.Setenv cannot be used in parallel tests
+func TestTimeoutsValueUpdateEnv(t *testing.T) {
+	type testCase struct {
+		timeoutsValue   timeouts.Value
+		override        string
+		multiplier      string
+		expectedTimeout time.Duration
+		expectedDiags   diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"multiplier-config": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"update": types.StringType,
+					},
+					map[string]attr.Value{
+						"update": types.StringValue("10m"),
+					},
+				),
+			},
+			multiplier:      "3",
+			expectedTimeout: 30 * time.Minute,
+		},
+		"multiplier-default": {
+			timeoutsValue: timeouts.Value{
+				Object: types.Object{},
+			},
+			multiplier:      "3",
+			expectedTimeout: time.Hour,
+		},
+		"multiplier-none": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"update": types.StringType,
+					},
+					map[string]attr.Value{
+						"update": types.StringValue("none"),
+					},
+				),
+			},
+			multiplier:      "3",
+			expectedTimeout: timeouts.NoTimeout,
+		},
+		"override-config": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"update": types.StringType,
+					},
+					map[string]attr.Value{
+						"update": types.StringValue("10m"),
+					},
+				),
+			},
+			override:        "2h",
+			multiplier:      "3",
+			expectedTimeout: 2 * time.Hour,
+		},
+		"override-not-parseable-as-time-duration": {
+			timeoutsValue: timeouts.Value{
+				Object: types.Object{},
+			},
+			override:        "10x",
+			expectedTimeout: 20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`timeout for "update" cannot be parsed from the TF_TIMEOUTS_UPDATE environment variable, time: unknown unit "x" in duration "10x"`,
+				),
+			},
+		},
+		"multiplier-not-parseable-config": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"update": types.StringType,
+					},
+					map[string]attr.Value{
+						"update": types.StringValue("10m"),
+					},
+				),
+			},
+			multiplier:      "three",
+			expectedTimeout: 10 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`timeout multiplier cannot be parsed from the TF_TIMEOUTS_MULTIPLIER environment variable, strconv.ParseFloat: parsing "three": invalid syntax`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("TF_TIMEOUTS_UPDATE", test.override)
+			t.Setenv("TF_TIMEOUTS_MULTIPLIER", test.multiplier)
+
+			gotTimeout, gotErr := test.timeoutsValue.Update(context.Background(), 20*time.Minute)
+
+			if diff := cmp.Diff(gotTimeout, test.expectedTimeout); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotErr, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/explain"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolution"
+)
+
+// Explanation describes the resolution of the timeout for each operation
+// supported by Value, as returned by Explain. String returns a human readable
+// description, while Map returns the explanation as structured log fields.
+type Explanation = resolution.Explanation
+
+// OperationExplanation describes the resolution of the timeout for a single
+// operation, including each step of the fallback chain which was walked.
+type OperationExplanation = resolution.OperationExplanation
+
+// Explain returns the Explanation of the timeouts for the "create", "read",
+// "update", "delete" and "plan" operations, followed by the import timeout, for
+// use when debugging. For each operation it contains the configured value, each
+// step of the fallback chain through defaults and environment variable overrides,
+// and the effective timeout. A step is included for the "deadline" attribute, if
+// it is set, for every operation other than import.
+//
+// The defaultTimeouts map, keyed by operation name, contains the default timeout
+// which would be supplied to the accessor for each operation, or to Import for
+// "import". Operations without an entry are explained with a zero default, while
+// operations whose attribute is not present in the Value are not explained. Any
+// diagnostics generated for the operations are returned together.
+func (t Value) Explain(ctx context.Context, defaultTimeouts map[string]time.Duration) (Explanation, diag.Diagnostics) {
+	return t.ExplainAtPath(ctx, defaultTimeouts, path.Root("timeouts"))
+}
+
+// ExplainAtPath is the same as Explain, but the Path of each Resolution is within
+// the timeouts attribute or block at timeoutsPath.
+func (t Value) ExplainAtPath(ctx context.Context, defaultTimeouts map[string]time.Duration, timeoutsPath path.Path) (Explanation, diag.Diagnostics) {
+	deadline, ok, diags := t.absoluteDeadline()
+
+	e, d := core.Explain(ctx, t, defaultTimeouts, timeoutsPath)
+	diags.Append(d...)
+
+	if ok {
+		step := fmt.Sprintf("deadline %s limits the operation if earlier than the timeout", deadline.Format(time.RFC3339))
+
+		for i := range e.Operations {
+			e.Operations[i].Steps = append(e.Operations[i].Steps, step)
+		}
+	}
+
+	ctx = explain.ContextWithTrace(ctx)
+
+	r, d := ResolveImport(ctx, defaultTimeouts[operationNameImport])
+	diags.Append(d...)
+
+	e.Operations = append(e.Operations, OperationExplanation{
+		Resolution: r,
+		Steps:      explain.Steps(ctx),
+	})
+
+	return e, diags
+}
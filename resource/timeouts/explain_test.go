@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestTimeoutsValueExplain(t *testing.T) {
+	t.Setenv("TF_TIMEOUTS_DELETE", "2h")
+	t.Setenv("TF_TIMEOUTS_MULTIPLIER", "")
+
+	timeoutsValue := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create":   types.StringType,
+				"read":     types.StringType,
+				"update":   types.StringType,
+				"delete":   types.StringType,
+				"deadline": types.StringType,
+			},
+			map[string]attr.Value{
+				"create":   types.StringValue("2x"),
+				"read":     types.StringNull(),
+				"update":   types.StringValue("none"),
+				"delete":   types.StringValue("10m"),
+				"deadline": types.StringValue("2026-11-01T06:00:00Z"),
+			},
+		),
+	}
+
+	ctx := timeouts.ContextWithDefaults(context.Background(), timeouts.Defaults{
+		Read: 5 * time.Minute,
+	})
+
+	got, diags := timeoutsValue.Explain(ctx, map[string]time.Duration{
+		"create": 20 * time.Minute,
+		"read":   10 * time.Minute,
+		"update": 20 * time.Minute,
+		"delete": 20 * time.Minute,
+		"plan":   time.Minute,
+		"import": 15 * time.Minute,
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	deadlineStep := "deadline 2026-11-01T06:00:00Z limits the operation if earlier than the timeout"
+
+	expected := timeouts.Explanation{
+		Operations: []timeouts.OperationExplanation{
+			{
+				Resolution: timeouts.Resolution{
+					Operation: "create",
+					Timeout:   40 * time.Minute,
+					Source:    timeouts.SourceConfig,
+					Raw:       "2x",
+					Path:      path.Root("timeouts").AtName("create"),
+				},
+				Steps: []string{
+					"default 20m0s",
+					`configuration "2x" resolves to 40m0s`,
+					deadlineStep,
+				},
+			},
+			{
+				Resolution: timeouts.Resolution{
+					Operation: "read",
+					Timeout:   5 * time.Minute,
+					Source:    timeouts.SourceProviderDefault,
+					Path:      path.Root("timeouts").AtName("read"),
+				},
+				Steps: []string{
+					"provider default 5m0s replaces default 10m0s",
+					"configuration is null or unknown, using default",
+					deadlineStep,
+				},
+			},
+			{
+				Resolution: timeouts.Resolution{
+					Operation: "update",
+					Timeout:   timeouts.NoTimeout,
+					Source:    timeouts.SourceConfig,
+					Raw:       "none",
+					Path:      path.Root("timeouts").AtName("update"),
+				},
+				Steps: []string{
+					"default 20m0s",
+					`configuration "none" resolves to none`,
+					deadlineStep,
+				},
+			},
+			{
+				Resolution: timeouts.Resolution{
+					Operation: "delete",
+					Timeout:   2 * time.Hour,
+					Source:    timeouts.SourceOverride,
+					Raw:       "10m",
+					Path:      path.Root("timeouts").AtName("delete"),
+				},
+				Steps: []string{
+					"default 20m0s",
+					`configuration "10m" resolves to 10m0s`,
+					"environment variable TF_TIMEOUTS_DELETE overrides timeout with 2h0m0s",
+					deadlineStep,
+				},
+			},
+			{
+				Resolution: timeouts.Resolution{
+					Operation: "import",
+					Timeout:   15 * time.Minute,
+					Source:    timeouts.SourceDefault,
+				},
+				Steps: []string{
+					"default 15m0s",
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected explanation difference: %s", diff)
+	}
+}
+
+func TestTimeoutsValueExplainDeadlineNotParseable(t *testing.T) {
+	t.Parallel()
+
+	timeoutsValue := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create":   types.StringType,
+				"delete":   types.StringType,
+				"deadline": types.StringType,
+			},
+			map[string]attr.Value{
+				"create":   types.StringNull(),
+				"delete":   types.StringNull(),
+				"deadline": types.StringValue("tomorrow"),
+			},
+		),
+	}
+
+	got, diags := timeoutsValue.Explain(context.Background(), nil)
+
+	// The deadline is parsed once, rather than for each operation.
+	if got, expected := diags.ErrorsCount(), 1; got != expected {
+		t.Errorf("expected %d error diagnostics, got %d: %v", expected, got, diags)
+	}
+
+	var operations []string
+
+	for _, o := range got.Operations {
+		operations = append(operations, o.Operation)
+	}
+
+	if diff := cmp.Diff(operations, []string{"create", "delete", "import"}); diff != "" {
+		t.Errorf("unexpected operations difference: %s", diff)
+	}
+}
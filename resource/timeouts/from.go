@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// FromPlan returns the Value of the "timeouts" attribute or block at the root of
+// the plan, for providers which read attributes individually rather than into a
+// model containing a Value field. The accessors of the returned Value, such as
+// Create, resolve the timeouts as usual.
+func FromPlan(ctx context.Context, plan tfsdk.Plan) (Value, diag.Diagnostics) {
+	return FromPlanAtPath(ctx, plan, path.Root("timeouts"))
+}
+
+// FromPlanAtPath is the same as FromPlan, but returns the Value of the
+// attribute or block at timeoutsPath.
+func FromPlanAtPath(ctx context.Context, plan tfsdk.Plan, timeoutsPath path.Path) (Value, diag.Diagnostics) {
+	return core.ValueAtPath(ctx, plan.GetAttribute, timeoutsPath)
+}
+
+// FromState returns the Value of the "timeouts" attribute or block at the root of
+// the state, for providers which read attributes individually rather than into a
+// model containing a Value field. The accessors of the returned Value, such as
+// Create, resolve the timeouts as usual.
+func FromState(ctx context.Context, state tfsdk.State) (Value, diag.Diagnostics) {
+	return FromStateAtPath(ctx, state, path.Root("timeouts"))
+}
+
+// FromStateAtPath is the same as FromState, but returns the Value of the
+// attribute or block at timeoutsPath.
+func FromStateAtPath(ctx context.Context, state tfsdk.State, timeoutsPath path.Path) (Value, diag.Diagnostics) {
+	return core.ValueAtPath(ctx, state.GetAttribute, timeoutsPath)
+}
+
+// FromConfig returns the Value of the "timeouts" attribute or block at the root of
+// the configuration, for providers which read attributes individually rather than into a
+// model containing a Value field. The accessors of the returned Value, such as
+// Create, resolve the timeouts as usual.
+func FromConfig(ctx context.Context, config tfsdk.Config) (Value, diag.Diagnostics) {
+	return FromConfigAtPath(ctx, config, path.Root("timeouts"))
+}
+
+// FromConfigAtPath is the same as FromConfig, but returns the Value of the
+// attribute or block at timeoutsPath.
+func FromConfigAtPath(ctx context.Context, config tfsdk.Config, timeoutsPath path.Path) (Value, diag.Diagnostics) {
+	return core.ValueAtPath(ctx, config.GetAttribute, timeoutsPath)
+}
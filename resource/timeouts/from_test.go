@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestFromPlanStateConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	timeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+		},
+	}
+
+	expected := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringValue("10m"),
+			},
+		),
+	}
+
+	type testCase struct {
+		schema schema.Schema
+	}
+	tests := map[string]testCase{
+		"block": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"timeouts": timeouts.Block(ctx, timeouts.Opts{
+						Create: true,
+					}),
+				},
+			},
+		},
+		"attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+						Create: true,
+					}),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			raw := tftypes.NewValue(test.schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+					"create": tftypes.NewValue(tftypes.String, "10m"),
+				}),
+			})
+
+			gotPlan, diags := timeouts.FromPlan(ctx, tfsdk.Plan{Schema: test.schema, Raw: raw})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(gotPlan, expected); diff != "" {
+				t.Errorf("unexpected plan value difference: %s", diff)
+			}
+
+			gotState, diags := timeouts.FromState(ctx, tfsdk.State{Schema: test.schema, Raw: raw})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(gotState, expected); diff != "" {
+				t.Errorf("unexpected state value difference: %s", diff)
+			}
+
+			gotConfig, diags := timeouts.FromConfig(ctx, tfsdk.Config{Schema: test.schema, Raw: raw})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(gotConfig, expected); diff != "" {
+				t.Errorf("unexpected config value difference: %s", diff)
+			}
+
+			gotCreate, _ := gotPlan.Create(ctx, 20*time.Minute)
+
+			if gotCreate != 10*time.Minute {
+				t.Errorf("expected create timeout %s, got %s", 10*time.Minute, gotCreate)
+			}
+		})
+	}
+}
+
+func TestFromPlanAtPath(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"settings": schema.SingleNestedBlock{
+				Blocks: map[string]schema.Block{
+					"operation_timeouts": timeouts.Block(ctx, timeouts.Opts{
+						Delete: true,
+					}),
+				},
+			},
+		},
+	}
+
+	timeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"delete": tftypes.String,
+		},
+	}
+
+	settingsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"operation_timeouts": timeoutsType,
+		},
+	}
+
+	plan := tfsdk.Plan{
+		Schema: s,
+		Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"settings": tftypes.NewValue(settingsType, map[string]tftypes.Value{
+				"operation_timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+					"delete": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+		}),
+	}
+
+	got, diags := timeouts.FromPlanAtPath(ctx, plan, path.Root("settings").AtName("operation_timeouts"))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	expected := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"delete": types.StringType,
+			},
+			map[string]attr.Value{
+				"delete": types.StringNull(),
+			},
+		),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+
+	_, diags = timeouts.FromPlan(ctx, plan)
+
+	if !diags.HasError() {
+		t.Errorf("expected error diagnostics for missing timeouts, got none")
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	// EnvImportTimeout is the name of the environment variable which, when set,
+	// overrides the timeout returned by Import. The value must be parseable as
+	// time.Duration, such as "30m" or "2h45m".
+	EnvImportTimeout = "TF_TIMEOUTS_IMPORT"
+
+	operationNameImport = "import"
+)
+
+// Import returns the timeout to be used within the ImportState function of a
+// resource. Terraform does not supply configuration during import, so the
+// import timeout cannot be set in a "timeouts" attribute or block. Instead, the
+// supplied default timeout, which is typically defined once by the provider, is
+// used unless the EnvImportTimeout environment variable is set, in which case
+// its value is parsed as time.Duration and returned. The supplied default timeout
+// is replaced by any import default stored in ctx by ContextWithProviderMeta or
+// ContextWithDefaults, and the TF_TIMEOUTS_MULTIPLIER environment variable is
+// applied as for the Value accessors. If any diagnostics are generated, such as
+// for an environment variable which cannot be parsed, they are returned along
+// with the timeout resolved without it, which may be a provider or module
+// default rather than the supplied default timeout.
+func Import(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	r, diags := ResolveImport(ctx, defaultTimeout)
+
+	return r.Timeout, diags
+}
+
+// ResolveImport returns the Resolution of the timeout returned by Import. As the
+// import timeout has no attribute, the Raw and Path fields are always empty. If
+// any diagnostics are generated they are returned along with the Resolution of
+// the timeout returned by Import.
+func ResolveImport(ctx context.Context, defaultTimeout time.Duration) (Resolution, diag.Diagnostics) {
+	return core.ResolveUnconfigured(ctx, operationNameImport, defaultTimeout)
+}
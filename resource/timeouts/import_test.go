@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+//nolint:paralleltest // t.Setenv cannot be used in parallel tests
+func TestImport(t *testing.T) {
+	type testCase struct {
+		env             string
+		defaults        timeouts.Defaults
+		expectedTimeout time.Duration
+		expectedDiags   diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"env-not-set": {
+			expectedTimeout: 20 * time.Minute,
+		},
+		"env-set": {
+			env:             "45m",
+			expectedTimeout: 45 * time.Minute,
+		},
+		"provider-default": {
+			defaults: timeouts.Defaults{
+				Import: 30 * time.Minute,
+			},
+			expectedTimeout: 30 * time.Minute,
+		},
+		"env-set-overrides-provider-default": {
+			env: "45m",
+			defaults: timeouts.Defaults{
+				Import: 30 * time.Minute,
+			},
+			expectedTimeout: 45 * time.Minute,
+		},
+		"env-not-parseable-as-time-duration": {
+			env:             "10x",
+			expectedTimeout: 20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`timeout for "import" cannot be parsed from the TF_TIMEOUTS_IMPORT environment variable, time: unknown unit "x" in duration "10x"`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(timeouts.EnvImportTimeout, test.env)
+
+			ctx := timeouts.ContextWithDefaults(context.Background(), test.defaults)
+
+			gotTimeout, gotErr := timeouts.Import(ctx, 20*time.Minute)
+
+			if diff := cmp.Diff(gotTimeout, test.expectedTimeout); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotErr, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
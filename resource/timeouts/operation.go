@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Operation identifies an operation whose timeout can be configured.
+type Operation string
+
+const (
+	// OperationCreate is the create operation, whose timeout is returned by Create.
+	OperationCreate Operation = attributeNameCreate
+
+	// OperationRead is the read operation, whose timeout is returned by Read.
+	OperationRead Operation = attributeNameRead
+
+	// OperationUpdate is the update operation, whose timeout is returned by Update.
+	OperationUpdate Operation = attributeNameUpdate
+
+	// OperationDelete is the delete operation, whose timeout is returned by Delete.
+	OperationDelete Operation = attributeNameDelete
+
+	// OperationPlan is the plan operation, whose timeout is returned by Plan.
+	OperationPlan Operation = attributeNamePlan
+)
+
+// Request is the set of framework request types for which OperationForRequest
+// infers the operation.
+type Request interface {
+	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest | resource.ModifyPlanRequest
+}
+
+// OperationForRequest returns the Operation performed by the framework method
+// which receives req, such as OperationCreate for resource.CreateRequest.
+func OperationForRequest[R Request](req R) Operation {
+	switch any(req).(type) {
+	case resource.CreateRequest:
+		return OperationCreate
+	case resource.ReadRequest:
+		return OperationRead
+	case resource.UpdateRequest:
+		return OperationUpdate
+	case resource.DeleteRequest:
+		return OperationDelete
+	case resource.ModifyPlanRequest:
+		return OperationPlan
+	}
+
+	// Unreachable, as the cases above cover every type in Request.
+	return ""
+}
+
+// ForRequest returns the timeout for the operation performed by the framework
+// method which receives req, so that the accessor cannot be mismatched with the
+// method, such as by copying code between methods. If any diagnostics are
+// generated they are returned along with the supplied default timeout.
+func ForRequest[R Request](ctx context.Context, t Value, req R, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.Timeout(ctx, OperationForRequest(req), defaultTimeout)
+}
+
+// Timeout returns the timeout for op, as returned by the accessor for the
+// operation, such as Create for OperationCreate. If any diagnostics are generated
+// they are returned along with the supplied default timeout.
+func (t Value) Timeout(ctx context.Context, op Operation, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	return t.getTimeout(ctx, string(op), defaultTimeout)
+}
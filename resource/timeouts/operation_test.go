@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestOperationForRequest(t *testing.T) {
+	t.Parallel()
+
+	if got := timeouts.OperationForRequest(resource.CreateRequest{}); got != timeouts.OperationCreate {
+		t.Errorf("expected %q, got %q", timeouts.OperationCreate, got)
+	}
+
+	if got := timeouts.OperationForRequest(resource.ReadRequest{}); got != timeouts.OperationRead {
+		t.Errorf("expected %q, got %q", timeouts.OperationRead, got)
+	}
+
+	if got := timeouts.OperationForRequest(resource.UpdateRequest{}); got != timeouts.OperationUpdate {
+		t.Errorf("expected %q, got %q", timeouts.OperationUpdate, got)
+	}
+
+	if got := timeouts.OperationForRequest(resource.DeleteRequest{}); got != timeouts.OperationDelete {
+		t.Errorf("expected %q, got %q", timeouts.OperationDelete, got)
+	}
+
+	if got := timeouts.OperationForRequest(resource.ModifyPlanRequest{}); got != timeouts.OperationPlan {
+		t.Errorf("expected %q, got %q", timeouts.OperationPlan, got)
+	}
+}
+
+func TestForRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	value := timeouts.NewValueMust(timeouts.Opts{Create: true, Update: true}, map[string]time.Duration{
+		"create": 30 * time.Minute,
+		"update": 45 * time.Minute,
+	})
+
+	gotCreate, diags := timeouts.ForRequest(ctx, value, resource.CreateRequest{}, 20*time.Minute)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if gotCreate != 30*time.Minute {
+		t.Errorf("expected create timeout %s, got %s", 30*time.Minute, gotCreate)
+	}
+
+	gotUpdate, _ := timeouts.ForRequest(ctx, value, resource.UpdateRequest{}, 20*time.Minute)
+
+	if gotUpdate != 45*time.Minute {
+		t.Errorf("expected update timeout %s, got %s", 45*time.Minute, gotUpdate)
+	}
+
+	gotDelete, _ := timeouts.ForRequest(ctx, value, resource.DeleteRequest{}, 20*time.Minute)
+
+	if gotDelete != 20*time.Minute {
+		t.Errorf("expected delete timeout %s, got %s", 20*time.Minute, gotDelete)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/logging"
+)
+
+const (
+	providerMetaAttributeNameDefaultTimeouts  = "default_timeouts"
+	providerMetaAttributeNameResourceTimeouts = "resource_timeouts"
+)
+
+// moduleDefaultsContextKey is the context key under which Defaults read from
+// provider_meta are stored.
+type moduleDefaultsContextKey struct{}
+
+// ContextWithProviderMeta reads the default timeouts for the named resource type
+// from the provider_meta block of the module, as defined by the provider/timeouts
+// MetaSchema function, and returns a copy of ctx containing them. The Value
+// accessors, and Import, consult these module defaults when a timeout has not been
+// set in configuration, before any provider defaults stored by ContextWithDefaults.
+// Timeouts configured for the resource type within "resource_timeouts" take
+// precedence over those configured within "default_timeouts". As the provider
+// meta schema does not support validators, each value is validated as it is read,
+// with invalid values reported at their attribute and left unset.
+//
+// The resource type is also added to the fields of the timeouts logging subsystem.
+// If the module does not contain a provider_meta block, no module defaults are
+// stored in the returned context. Either attribute may be absent from the provider
+// meta schema, in which case it is skipped.
+func ContextWithProviderMeta(ctx context.Context, providerMeta tfsdk.Config, resourceType string) (context.Context, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ctx = logging.SetField(ctx, logging.KeyResourceType, resourceType)
+
+	if providerMeta.Schema == nil || providerMeta.Raw.IsNull() {
+		return ctx, diags
+	}
+
+	// Provider meta schemas need not contain both attributes, so any which are
+	// absent are left null rather than read.
+	moduleTimeouts := types.ObjectNull(nil)
+
+	if _, d := providerMeta.Schema.TypeAtPath(ctx, path.Root(providerMetaAttributeNameDefaultTimeouts)); !d.HasError() {
+		diags.Append(providerMeta.GetAttribute(ctx, path.Root(providerMetaAttributeNameDefaultTimeouts), &moduleTimeouts)...)
+	}
+
+	resourceTimeouts := types.MapNull(types.StringType)
+
+	if _, d := providerMeta.Schema.TypeAtPath(ctx, path.Root(providerMetaAttributeNameResourceTimeouts)); !d.HasError() {
+		diags.Append(providerMeta.GetAttribute(ctx, path.Root(providerMetaAttributeNameResourceTimeouts), &resourceTimeouts)...)
+	}
+
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	defaults, d := defaultsFromObject(ctx, moduleTimeouts, path.Root(providerMetaAttributeNameDefaultTimeouts))
+	diags.Append(d...)
+
+	if resourceTypeTimeouts, ok := resourceTimeouts.Elements()[resourceType]; ok {
+		resourceTypeObject, ok := resourceTypeTimeouts.(types.Object)
+		if !ok {
+			diags.AddError(
+				"Provider Meta Timeouts Cannot Be Read",
+				fmt.Sprintf("timeouts for resource type %q must be an object, got %T", resourceType, resourceTypeTimeouts),
+			)
+
+			return ctx, diags
+		}
+
+		resourceTypeDefaults, d := defaultsFromObject(ctx, resourceTypeObject, path.Root(providerMetaAttributeNameResourceTimeouts).AtMapKey(resourceType))
+		diags.Append(d...)
+
+		defaults = resourceTypeDefaults.merge(defaults)
+	}
+
+	return context.WithValue(ctx, moduleDefaultsContextKey{}, defaults), diags
+}
+
+// moduleDefaultsFromContext returns the Defaults stored in ctx by
+// ContextWithProviderMeta. An empty Defaults is returned if none have been stored.
+func moduleDefaultsFromContext(ctx context.Context) Defaults {
+	defaults, _ := ctx.Value(moduleDefaultsContextKey{}).(Defaults)
+
+	return defaults
+}
@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	providertimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/provider/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestContextWithProviderMeta(t *testing.T) {
+	t.Parallel()
+
+	metaSchema := providertimeouts.MetaSchema(context.Background(), providertimeouts.DefaultsOpts{
+		Create: true,
+		Read:   true,
+	})
+	timeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+			"read":   tftypes.String,
+		},
+	}
+	metaType := metaSchema.Type().TerraformType(context.Background())
+
+	moduleNameSchema := metaschema.Schema{
+		Attributes: map[string]metaschema.Attribute{
+			"module_name": metaschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+	defaultTimeoutsSchema := metaschema.Schema{
+		Attributes: map[string]metaschema.Attribute{
+			"default_timeouts": metaSchema.Attributes["default_timeouts"],
+		},
+	}
+
+	type testCase struct {
+		providerMeta   tfsdk.Config
+		defaults       timeouts.Defaults
+		timeoutsValue  timeouts.Value
+		expectedCreate time.Duration
+		expectedRead   time.Duration
+		expectedDiags  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"no-provider-meta": {
+			providerMeta: tfsdk.Config{},
+			defaults: timeouts.Defaults{
+				Create: 90 * time.Minute,
+			},
+			expectedCreate: 90 * time.Minute,
+			expectedRead:   20 * time.Minute,
+		},
+		"no-timeouts-attributes": {
+			providerMeta: tfsdk.Config{
+				Schema: moduleNameSchema,
+				Raw: tftypes.NewValue(moduleNameSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+					"module_name": tftypes.NewValue(tftypes.String, "example"),
+				}),
+			},
+			defaults: timeouts.Defaults{
+				Create: 90 * time.Minute,
+			},
+			expectedCreate: 90 * time.Minute,
+			expectedRead:   20 * time.Minute,
+		},
+		"default-timeouts-attribute-only": {
+			providerMeta: tfsdk.Config{
+				Schema: defaultTimeoutsSchema,
+				Raw: tftypes.NewValue(defaultTimeoutsSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+					"default_timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+						"create": tftypes.NewValue(tftypes.String, "45m"),
+						"read":   tftypes.NewValue(tftypes.String, nil),
+					}),
+				}),
+			},
+			expectedCreate: 45 * time.Minute,
+			expectedRead:   20 * time.Minute,
+		},
+		"default-timeouts": {
+			providerMeta: tfsdk.Config{
+				Schema: metaSchema,
+				Raw: tftypes.NewValue(metaType, map[string]tftypes.Value{
+					"default_timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+						"create": tftypes.NewValue(tftypes.String, "45m"),
+						"read":   tftypes.NewValue(tftypes.String, nil),
+					}),
+					"resource_timeouts": tftypes.NewValue(tftypes.Map{ElementType: timeoutsType}, nil),
+				}),
+			},
+			defaults: timeouts.Defaults{
+				Create: 90 * time.Minute,
+				Read:   10 * time.Minute,
+			},
+			expectedCreate: 45 * time.Minute,
+			expectedRead:   10 * time.Minute,
+		},
+		"resource-timeouts": {
+			providerMeta: tfsdk.Config{
+				Schema: metaSchema,
+				Raw: tftypes.NewValue(metaType, map[string]tftypes.Value{
+					"default_timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+						"create": tftypes.NewValue(tftypes.String, "45m"),
+						"read":   tftypes.NewValue(tftypes.String, "5m"),
+					}),
+					"resource_timeouts": tftypes.NewValue(tftypes.Map{ElementType: timeoutsType}, map[string]tftypes.Value{
+						"example_thing": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+							"create": tftypes.NewValue(tftypes.String, "2h"),
+							"read":   tftypes.NewValue(tftypes.String, nil),
+						}),
+						"example_other": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+							"create": tftypes.NewValue(tftypes.String, "3h"),
+							"read":   tftypes.NewValue(tftypes.String, "3h"),
+						}),
+					}),
+				}),
+			},
+			expectedCreate: 2 * time.Hour,
+			expectedRead:   5 * time.Minute,
+		},
+		"config-overrides-provider-meta": {
+			providerMeta: tfsdk.Config{
+				Schema: metaSchema,
+				Raw: tftypes.NewValue(metaType, map[string]tftypes.Value{
+					"default_timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+						"create": tftypes.NewValue(tftypes.String, "45m"),
+						"read":   tftypes.NewValue(tftypes.String, nil),
+					}),
+					"resource_timeouts": tftypes.NewValue(tftypes.Map{ElementType: timeoutsType}, nil),
+				}),
+			},
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("10m"),
+					},
+				),
+			},
+			expectedCreate: 10 * time.Minute,
+			expectedRead:   20 * time.Minute,
+		},
+		"not-parseable-as-time-duration": {
+			providerMeta: tfsdk.Config{
+				Schema: metaSchema,
+				Raw: tftypes.NewValue(metaType, map[string]tftypes.Value{
+					"default_timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+						"create": tftypes.NewValue(tftypes.String, "10x"),
+						"read":   tftypes.NewValue(tftypes.String, "5m"),
+					}),
+					"resource_timeouts": tftypes.NewValue(tftypes.Map{ElementType: timeoutsType}, nil),
+				}),
+			},
+			expectedCreate: 20 * time.Minute,
+			expectedRead:   5 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("default_timeouts").AtName("create"),
+					"Invalid Attribute Value Time Duration",
+					`"10x" must be a string containing a sequence of decimal numbers, each with optional fraction `+
+						`and a unit suffix, such as "300ms", "1.5h" or "2h45m", which is greater than zero. Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".`,
+				),
+			},
+		},
+		"resource-timeouts-not-parseable-as-time-duration": {
+			providerMeta: tfsdk.Config{
+				Schema: metaSchema,
+				Raw: tftypes.NewValue(metaType, map[string]tftypes.Value{
+					"default_timeouts": tftypes.NewValue(timeoutsType, nil),
+					"resource_timeouts": tftypes.NewValue(tftypes.Map{ElementType: timeoutsType}, map[string]tftypes.Value{
+						"example_thing": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+							"create": tftypes.NewValue(tftypes.String, "2x"),
+							"read":   tftypes.NewValue(tftypes.String, nil),
+						}),
+					}),
+				}),
+			},
+			expectedCreate: 20 * time.Minute,
+			expectedRead:   20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("resource_timeouts").AtMapKey("example_thing").AtName("create"),
+					"Invalid Attribute Value Time Duration",
+					`"2x" must be a string containing a sequence of decimal numbers, each with optional fraction `+
+						`and a unit suffix, such as "300ms", "1.5h" or "2h45m", which is greater than zero. Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := timeouts.ContextWithDefaults(context.Background(), test.defaults)
+
+			ctx, gotErr := timeouts.ContextWithProviderMeta(ctx, test.providerMeta, "example_thing")
+
+			if diff := cmp.Diff(gotErr, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+
+			gotCreate, _ := test.timeoutsValue.Create(ctx, 20*time.Minute)
+
+			if diff := cmp.Diff(gotCreate, test.expectedCreate); diff != "" {
+				t.Errorf("unexpected create timeout difference: %s", diff)
+			}
+
+			gotRead, _ := test.timeoutsValue.Read(ctx, 20*time.Minute)
+
+			if diff := cmp.Diff(gotRead, test.expectedRead); diff != "" {
+				t.Errorf("unexpected read timeout difference: %s", diff)
+			}
+		})
+	}
+}
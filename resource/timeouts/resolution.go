@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolution"
+)
+
+// Resolution describes the timeout resolved for an operation by a Value accessor,
+// including where it was resolved from, so that callers can distinguish a
+// configured timeout from a default or an environment variable override.
+type Resolution = resolution.Resolution
+
+// ResolveCreate returns the Resolution of the timeout returned by Create, describing
+// the effective timeout along with where it was resolved from, the configured
+// value and the path of the "create" attribute. If any diagnostics are
+// generated they are returned along with a Resolution of the supplied default
+// timeout.
+func (t Value) ResolveCreate(ctx context.Context, defaultTimeout time.Duration) (Resolution, diag.Diagnostics) {
+	return t.resolveTimeout(ctx, attributeNameCreate, defaultTimeout, path.Root("timeouts"))
+}
+
+// ResolveRead returns the Resolution of the timeout returned by Read, describing
+// the effective timeout along with where it was resolved from, the configured
+// value and the path of the "read" attribute. If any diagnostics are
+// generated they are returned along with a Resolution of the supplied default
+// timeout.
+func (t Value) ResolveRead(ctx context.Context, defaultTimeout time.Duration) (Resolution, diag.Diagnostics) {
+	return t.resolveTimeout(ctx, attributeNameRead, defaultTimeout, path.Root("timeouts"))
+}
+
+// ResolveUpdate returns the Resolution of the timeout returned by Update, describing
+// the effective timeout along with where it was resolved from, the configured
+// value and the path of the "update" attribute. If any diagnostics are
+// generated they are returned along with a Resolution of the supplied default
+// timeout.
+func (t Value) ResolveUpdate(ctx context.Context, defaultTimeout time.Duration) (Resolution, diag.Diagnostics) {
+	return t.resolveTimeout(ctx, attributeNameUpdate, defaultTimeout, path.Root("timeouts"))
+}
+
+// ResolveDelete returns the Resolution of the timeout returned by Delete, describing
+// the effective timeout along with where it was resolved from, the configured
+// value and the path of the "delete" attribute. If any diagnostics are
+// generated they are returned along with a Resolution of the supplied default
+// timeout.
+func (t Value) ResolveDelete(ctx context.Context, defaultTimeout time.Duration) (Resolution, diag.Diagnostics) {
+	return t.resolveTimeout(ctx, attributeNameDelete, defaultTimeout, path.Root("timeouts"))
+}
+
+// ResolvePlan returns the Resolution of the timeout returned by Plan, describing
+// the effective timeout along with where it was resolved from, the configured
+// value and the path of the "plan" attribute. If any diagnostics are
+// generated they are returned along with a Resolution of the supplied default
+// timeout.
+func (t Value) ResolvePlan(ctx context.Context, defaultTimeout time.Duration) (Resolution, diag.Diagnostics) {
+	return t.resolveTimeout(ctx, attributeNamePlan, defaultTimeout, path.Root("timeouts"))
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestTimeoutsValueResolveCreate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		timeoutsValue      timeouts.Value
+		expectedResolution timeouts.Resolution
+		expectedDiags      diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"create": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("10m"),
+					},
+				),
+			},
+			expectedResolution: timeouts.Resolution{
+				Operation: "create",
+				Timeout:   10 * time.Minute,
+				Source:    timeouts.SourceConfig,
+				Raw:       "10m",
+				Path:      path.Root("timeouts").AtName("create"),
+			},
+		},
+		"create-relative": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("2x"),
+					},
+				),
+			},
+			expectedResolution: timeouts.Resolution{
+				Operation: "create",
+				Timeout:   40 * time.Minute,
+				Source:    timeouts.SourceConfig,
+				Raw:       "2x",
+				Path:      path.Root("timeouts").AtName("create"),
+			},
+		},
+		"create-null": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringNull(),
+					},
+				),
+			},
+			expectedResolution: timeouts.Resolution{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeouts.SourceDefault,
+				Path:      path.Root("timeouts").AtName("create"),
+			},
+		},
+		"create-not-parseable-as-time-duration": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("10y"),
+					},
+				),
+			},
+			expectedResolution: timeouts.Resolution{
+				Operation: "create",
+				Timeout:   20 * time.Minute,
+				Source:    timeouts.SourceDefault,
+				Raw:       "10y",
+				Path:      path.Root("timeouts").AtName("create"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`timeout for "create" cannot be parsed, time: unknown unit "y" in duration "10y"`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResolution, gotErr := test.timeoutsValue.ResolveCreate(context.Background(), 20*time.Minute)
+
+			if diff := cmp.Diff(gotResolution, test.expectedResolution); diff != "" {
+				t.Errorf("unexpected resolution difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotErr, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeoutsValueResolveCreateEnv(t *testing.T) {
+	t.Setenv("TF_TIMEOUTS_CREATE", "2h")
+
+	timeoutsValue := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringValue("10m"),
+			},
+		),
+	}
+
+	gotResolution, gotErr := timeoutsValue.ResolveCreate(context.Background(), 20*time.Minute)
+
+	expectedResolution := timeouts.Resolution{
+		Operation: "create",
+		Timeout:   2 * time.Hour,
+		Source:    timeouts.SourceOverride,
+		Raw:       "10m",
+		Path:      path.Root("timeouts").AtName("create"),
+	}
+
+	if diff := cmp.Diff(gotResolution, expectedResolution); diff != "" {
+		t.Errorf("unexpected resolution difference: %s", diff)
+	}
+
+	if gotErr.HasError() {
+		t.Errorf("unexpected error diagnostics: %v", gotErr)
+	}
+}
+
+func TestTimeoutsValueResolveOperationAtPath(t *testing.T) {
+	t.Parallel()
+
+	timeoutsValue := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringValue("10m"),
+			},
+		),
+	}
+
+	timeoutsPath := path.Root("settings").AtName("operation_timeouts")
+
+	gotResolution, gotErr := timeoutsValue.ResolveOperationAtPath(context.Background(), "create", 20*time.Minute, timeoutsPath)
+
+	expectedResolution := timeouts.Resolution{
+		Operation: "create",
+		Timeout:   10 * time.Minute,
+		Source:    timeouts.SourceConfig,
+		Raw:       "10m",
+		Path:      timeoutsPath.AtName("create"),
+	}
+
+	if diff := cmp.Diff(gotResolution, expectedResolution); diff != "" {
+		t.Errorf("unexpected resolution difference: %s", diff)
+	}
+
+	if gotErr.HasError() {
+		t.Errorf("unexpected error diagnostics: %v", gotErr)
+	}
+}
+
+func TestResolveImport(t *testing.T) {
+	t.Parallel()
+
+	ctx := timeouts.ContextWithDefaults(context.Background(), timeouts.Defaults{
+		Import: 5 * time.Minute,
+	})
+
+	gotResolution, gotErr := timeouts.ResolveImport(ctx, 20*time.Minute)
+
+	expectedResolution := timeouts.Resolution{
+		Operation: "import",
+		Timeout:   5 * time.Minute,
+		Source:    timeouts.SourceProviderDefault,
+	}
+
+	if diff := cmp.Diff(gotResolution, expectedResolution); diff != "" {
+		t.Errorf("unexpected resolution difference: %s", diff)
+	}
+
+	if gotErr.HasError() {
+		t.Errorf("unexpected error diagnostics: %v", gotErr)
+	}
+}
+
+func TestTimeoutsValueResolveCreateLogging(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_TIMEOUTS", "DEBUG")
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	timeoutsValue := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringValue("2x"),
+			},
+		),
+	}
+
+	_, diags := timeoutsValue.ResolveCreate(ctx, 20*time.Minute)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to read log entries: %s", err)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"@level":                "debug",
+			"@message":              "resolved create timeout",
+			"@module":               "provider.timeouts",
+			"tf_timeouts_operation": "create",
+			"tf_timeouts_source":    "config",
+			"tf_timeouts_timeout":   "40m0s",
+			"tf_timeouts_value":     "2x",
+		},
+	}
+
+	if diff := cmp.Diff(entries, expected); diff != "" {
+		t.Errorf("unexpected log entries difference: %s", diff)
+	}
+}
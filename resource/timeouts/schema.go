@@ -19,6 +19,9 @@ const (
 	attributeNameRead   = "read"
 	attributeNameUpdate = "update"
 	attributeNameDelete = "delete"
+	attributeNamePlan   = "plan"
+
+	attributeNameDeadline = "deadline"
 )
 
 // Opts is used as an argument to Block and Attributes to indicate which attributes
@@ -29,10 +32,21 @@ type Opts struct {
 	Read              bool
 	Update            bool
 	Delete            bool
+	Plan              bool
 	CreateDescription string
 	ReadDescription   string
 	UpdateDescription string
 	DeleteDescription string
+	PlanDescription   string
+
+	// AllowNoTimeout indicates that the duration attributes should accept "none",
+	// for which the Value accessors return NoTimeout.
+	AllowNoTimeout bool
+
+	// Deadline indicates that a "deadline" attribute should be created, which
+	// accepts an RFC 3339 timestamp by which all operations must complete.
+	Deadline            bool
+	DeadlineDescription string
 }
 
 // Block returns a schema.Block containing attributes for each of the fields
@@ -104,6 +118,13 @@ func attributesMap(opts Opts) map[string]schema.Attribute {
 		},
 	}
 
+	if opts.AllowNoTimeout {
+		description += ` The value "none" disables the timeout.`
+		attribute.Validators = []validator.String{
+			validators.TimeDurationOrNone(),
+		}
+	}
+
 	if opts.Create {
 		attribute.Description = description
 
@@ -146,6 +167,35 @@ func attributesMap(opts Opts) map[string]schema.Attribute {
 		attributes[attributeNameDelete] = attribute
 	}
 
+	if opts.Plan {
+		attribute.Description = description + ` Plan operations occur during any planning operation ` +
+			`in which the resource plan is modified, such as when remote lookups are performed.`
+
+		if opts.PlanDescription != "" {
+			attribute.Description = opts.PlanDescription
+		}
+
+		attributes[attributeNamePlan] = attribute
+	}
+
+	if opts.Deadline {
+		deadline := schema.StringAttribute{
+			Optional: true,
+			Description: `A string containing an [RFC 3339](https://www.rfc-editor.org/rfc/rfc3339) timestamp, ` +
+				`such as "2026-11-01T06:00:00Z", by which all operations must complete. Operations are ` +
+				`limited by the earlier of this deadline and the timeout for the operation.`,
+			Validators: []validator.String{
+				validators.RFC3339(),
+			},
+		}
+
+		if opts.DeadlineDescription != "" {
+			deadline.Description = opts.DeadlineDescription
+		}
+
+		attributes[attributeNameDeadline] = deadline
+	}
+
 	return attributes
 }
 
@@ -168,5 +218,13 @@ func attrTypesMap(opts Opts) map[string]attr.Type {
 		attrTypes[attributeNameDelete] = types.StringType
 	}
 
+	if opts.Plan {
+		attrTypes[attributeNamePlan] = types.StringType
+	}
+
+	if opts.Deadline {
+		attrTypes[attributeNameDeadline] = types.StringType
+	}
+
 	return attrTypes
 }
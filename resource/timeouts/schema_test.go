@@ -155,6 +155,142 @@ func TestBlock(t *testing.T) {
 				},
 			},
 		},
+		"plan-opts": {
+			opts: timeouts.Opts{
+				Plan: true,
+			},
+			expected: schema.SingleNestedBlock{
+				CustomType: timeouts.Type{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"plan": types.StringType,
+						},
+					},
+				},
+				Attributes: map[string]schema.Attribute{
+					"plan": schema.StringAttribute{
+						Optional: true,
+						Description: `A string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) ` +
+							`consisting of numbers and unit suffixes, such as "30s" or "2h45m". Valid time units are ` +
+							`"s" (seconds), "m" (minutes), "h" (hours). Plan operations occur during any planning operation ` +
+							`in which the resource plan is modified, such as when remote lookups are performed.`,
+						Validators: []validator.String{
+							validators.TimeDuration(),
+						},
+					},
+				},
+			},
+		},
+		"deadline-opts": {
+			opts: timeouts.Opts{
+				Create:   true,
+				Deadline: true,
+			},
+			expected: schema.SingleNestedBlock{
+				CustomType: timeouts.Type{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"create":   types.StringType,
+							"deadline": types.StringType,
+						},
+					},
+				},
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Optional: true,
+						Description: `A string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) ` +
+							`consisting of numbers and unit suffixes, such as "30s" or "2h45m". Valid time units are ` +
+							`"s" (seconds), "m" (minutes), "h" (hours).`,
+						Validators: []validator.String{
+							validators.TimeDuration(),
+						},
+					},
+					"deadline": schema.StringAttribute{
+						Optional: true,
+						Description: `A string containing an [RFC 3339](https://www.rfc-editor.org/rfc/rfc3339) timestamp, ` +
+							`such as "2026-11-01T06:00:00Z", by which all operations must complete. Operations are ` +
+							`limited by the earlier of this deadline and the timeout for the operation.`,
+						Validators: []validator.String{
+							validators.RFC3339(),
+						},
+					},
+				},
+			},
+		},
+		"deadline-opts-description": {
+			opts: timeouts.Opts{
+				Deadline:            true,
+				DeadlineDescription: "deadline description",
+			},
+			expected: schema.SingleNestedBlock{
+				CustomType: timeouts.Type{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"deadline": types.StringType,
+						},
+					},
+				},
+				Attributes: map[string]schema.Attribute{
+					"deadline": schema.StringAttribute{
+						Optional:    true,
+						Description: "deadline description",
+						Validators: []validator.String{
+							validators.RFC3339(),
+						},
+					},
+				},
+			},
+		},
+		"allow-no-timeout-opts": {
+			opts: timeouts.Opts{
+				Create:         true,
+				AllowNoTimeout: true,
+			},
+			expected: schema.SingleNestedBlock{
+				CustomType: timeouts.Type{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"create": types.StringType,
+						},
+					},
+				},
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Optional: true,
+						Description: `A string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) ` +
+							`consisting of numbers and unit suffixes, such as "30s" or "2h45m". Valid time units are ` +
+							`"s" (seconds), "m" (minutes), "h" (hours). The value "none" disables the timeout.`,
+						Validators: []validator.String{
+							validators.TimeDurationOrNone(),
+						},
+					},
+				},
+			},
+		},
+		"plan-opts-description": {
+			opts: timeouts.Opts{
+				Plan:            true,
+				PlanDescription: "plan description",
+			},
+			expected: schema.SingleNestedBlock{
+				CustomType: timeouts.Type{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"plan": types.StringType,
+						},
+					},
+				},
+				Attributes: map[string]schema.Attribute{
+					"plan": schema.StringAttribute{
+						Optional:    true,
+						Description: "plan description",
+						Validators: []validator.String{
+							validators.TimeDuration(),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -376,6 +512,33 @@ func TestAttributes(t *testing.T) {
 				Optional: true,
 			},
 		},
+		"plan-opts": {
+			opts: timeouts.Opts{
+				Plan: true,
+			},
+			expected: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"plan": schema.StringAttribute{
+						Optional: true,
+						Description: `A string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) ` +
+							`consisting of numbers and unit suffixes, such as "30s" or "2h45m". Valid time units are ` +
+							`"s" (seconds), "m" (minutes), "h" (hours). Plan operations occur during any planning operation ` +
+							`in which the resource plan is modified, such as when remote lookups are performed.`,
+						Validators: []validator.String{
+							validators.TimeDuration(),
+						},
+					},
+				},
+				CustomType: timeouts.Type{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"plan": types.StringType,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/validators"
+)
+
+// attributeNameDefault is the name of the attribute generated by SDKv2 when the
+// resource sets a default timeout, which applies to every operation which has not
+// been configured.
+const attributeNameDefault = "default"
+
+// SDKv2Opts is used as an argument to BlockSDKv2 to indicate which attributes
+// should be created. Each field corresponds to the field of the same name in the
+// schema.ResourceTimeout of the SDKv2 resource being migrated, which generates an
+// attribute when it is not nil.
+type SDKv2Opts struct {
+	Create  bool
+	Read    bool
+	Update  bool
+	Delete  bool
+	Default bool
+}
+
+// AttributeNames returns the sorted names of the attributes of the block
+// returned by BlockSDKv2 for opts, which are those SDKv2 generates.
+func (o SDKv2Opts) AttributeNames() []string {
+	var names []string
+
+	for _, attribute := range []struct {
+		name    string
+		enabled bool
+	}{
+		{attributeNameCreate, o.Create},
+		{attributeNameDefault, o.Default},
+		{attributeNameDelete, o.Delete},
+		{attributeNameRead, o.Read},
+		{attributeNameUpdate, o.Update},
+	} {
+		if attribute.enabled {
+			names = append(names, attribute.name)
+		}
+	}
+
+	return names
+}
+
+// BlockSDKv2 returns a schema.Block equivalent to the timeouts block generated by
+// terraform-plugin-sdk/v2 for a resource with the timeouts in opts, so that the
+// schema of a resource migrated from SDKv2 to the framework, such as behind
+// terraform-plugin-mux, is unchanged. As with SDKv2, the attributes have no
+// descriptions and only accept values which can be parsed as time.Duration.
+//
+// When the "default" attribute is configured, the Value accessors use it for any
+// of the "create", "read", "update" and "delete" operations which has not been
+// configured, as SDKv2 does. Relative expressions such as "2x" are not accepted,
+// as SDKv2 does not accept them.
+func BlockSDKv2(ctx context.Context, opts SDKv2Opts) schema.Block {
+	attributes := map[string]schema.Attribute{}
+	attrTypes := map[string]attr.Type{}
+
+	attribute := schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			validators.AbsoluteTimeDuration(),
+		},
+	}
+
+	for _, name := range opts.AttributeNames() {
+		attributes[name] = attribute
+		attrTypes[name] = types.StringType
+	}
+
+	return schema.SingleNestedBlock{
+		Attributes: attributes,
+		CustomType: Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: attrTypes,
+			},
+		},
+	}
+}
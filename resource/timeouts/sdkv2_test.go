@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestBlockSDKv2(t *testing.T) {
+	t.Parallel()
+
+	attribute := schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			validators.AbsoluteTimeDuration(),
+		},
+	}
+
+	expected := schema.SingleNestedBlock{
+		CustomType: timeouts.Type{
+			ObjectType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"create":  types.StringType,
+					"delete":  types.StringType,
+					"default": types.StringType,
+				},
+			},
+		},
+		Attributes: map[string]schema.Attribute{
+			"create":  attribute,
+			"delete":  attribute,
+			"default": attribute,
+		},
+	}
+
+	got := timeouts.BlockSDKv2(context.Background(), timeouts.SDKv2Opts{
+		Create:  true,
+		Delete:  true,
+		Default: true,
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected block difference: %s", diff)
+	}
+}
+
+func TestTimeoutsValueCreateSDKv2Default(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		create          attr.Value
+		sdkv2Default    attr.Value
+		expectedTimeout time.Duration
+	}
+	tests := map[string]testCase{
+		"create": {
+			create:          types.StringValue("10m"),
+			sdkv2Default:    types.StringValue("30m"),
+			expectedTimeout: 10 * time.Minute,
+		},
+		"default": {
+			create:          types.StringNull(),
+			sdkv2Default:    types.StringValue("30m"),
+			expectedTimeout: 30 * time.Minute,
+		},
+		"neither": {
+			create:          types.StringNull(),
+			sdkv2Default:    types.StringNull(),
+			expectedTimeout: 20 * time.Minute,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value := timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create":  types.StringType,
+						"default": types.StringType,
+					},
+					map[string]attr.Value{
+						"create":  test.create,
+						"default": test.sdkv2Default,
+					},
+				),
+			}
+
+			got, diags := value.Create(context.Background(), 20*time.Minute)
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, test.expectedTimeout); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeoutsValuePlanSDKv2Default(t *testing.T) {
+	t.Parallel()
+
+	value := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"plan":    types.StringType,
+				"default": types.StringType,
+			},
+			map[string]attr.Value{
+				"plan":    types.StringNull(),
+				"default": types.StringValue("30m"),
+			},
+		),
+	}
+
+	// SDKv2 has no plan timeout, so the "default" attribute does not apply.
+	got, diags := value.Plan(context.Background(), 5*time.Minute)
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, 5*time.Minute); diff != "" {
+		t.Errorf("unexpected timeout difference: %s", diff)
+	}
+}
+
+func TestSDKv2OptsAttributeNames(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.SDKv2Opts{
+		Create:  true,
+		Update:  true,
+		Default: true,
+	}.AttributeNames()
+
+	if diff := cmp.Diff(got, []string{"create", "default", "update"}); diff != "" {
+		t.Errorf("unexpected attribute names difference: %s", diff)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SetNullState sets a null timeouts object, typed according to the schema of
+// state, into the "timeouts" attribute or block at the root of the schema. It is
+// intended for use within ImportState, where resp.State.SetAttribute is typically
+// used without setting the timeouts, and within Read when state is rebuilt from
+// scratch, so that a subsequent Get into a model containing a Value field does not
+// fail with a "Value Conversion Error".
+func SetNullState(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	return SetNullStateAtPath(ctx, state, path.Root("timeouts"))
+}
+
+// SetNullStateAtPath is the same as SetNullState, but sets the null timeouts
+// object into the attribute or block at timeoutsPath.
+func SetNullStateAtPath(ctx context.Context, state *tfsdk.State, timeoutsPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attrType, d := state.Schema.TypeAtPath(ctx, timeoutsPath)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	objectType, ok := attrType.(attr.TypeWithAttributeTypes)
+	if !ok {
+		diags.AddAttributeError(
+			timeoutsPath,
+			"Timeouts Cannot Be Set",
+			fmt.Sprintf("timeouts must be an object, got %s", attrType),
+		)
+
+		return diags
+	}
+
+	value := Value{
+		Object: types.ObjectNull(objectType.AttributeTypes()),
+	}
+
+	diags.Append(state.SetAttribute(ctx, timeoutsPath, value)...)
+
+	return diags
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestSetNullState(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		ID       types.String   `tfsdk:"id"`
+		Timeouts timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	type testCase struct {
+		schema        schema.Schema
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"block": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"timeouts": timeouts.Block(context.Background(), timeouts.Opts{
+						Create: true,
+						Delete: true,
+					}),
+				},
+			},
+		},
+		"attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"timeouts": timeouts.Attributes(context.Background(), timeouts.Opts{
+						Create: true,
+						Delete: true,
+					}),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			state := tfsdk.State{
+				Schema: test.schema,
+				Raw:    tftypes.NewValue(test.schema.Type().TerraformType(ctx), nil),
+			}
+
+			diags := state.SetAttribute(ctx, path.Root("id"), "example")
+
+			diags.Append(timeouts.SetNullState(ctx, &state)...)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			var got model
+
+			diags.Append(state.Get(ctx, &got)...)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			expected := model{
+				ID: types.StringValue("example"),
+				Timeouts: timeouts.Value{
+					Object: types.ObjectNull(map[string]attr.Type{
+						"create": types.StringType,
+						"delete": types.StringType,
+					}),
+				},
+			}
+
+			if diff := cmp.Diff(got, expected); diff != "" {
+				t.Errorf("unexpected model difference: %s", diff)
+			}
+
+			// Setting the model back into state must not fail.
+			diags.Append(state.Set(ctx, got)...)
+
+			if diags.HasError() {
+				t.Errorf("unexpected error diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestSetNullStateAtPathNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	state := tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}
+
+	diags := timeouts.SetNullStateAtPath(ctx, &state, path.Root("timeouts"))
+
+	if !diags.HasError() {
+		t.Errorf("expected error diagnostics, got none")
+	}
+}
@@ -177,6 +177,77 @@ func TestTimeoutsValueCreate(t *testing.T) {
 			expectedTimeout: 10 * time.Minute,
 			expectedDiags:   nil,
 		},
+		"create-relative-multiple": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("2x"),
+					},
+				),
+			},
+			expectedTimeout: 40 * time.Minute,
+		},
+		"create-relative-percentage": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("150%"),
+					},
+				),
+			},
+			expectedTimeout: 30 * time.Minute,
+		},
+		"create-relative-default-plus": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("default+10m"),
+					},
+				),
+			},
+			expectedTimeout: 30 * time.Minute,
+		},
+		"create-relative-negative": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("default-30m"),
+					},
+				),
+			},
+			expectedTimeout: 20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`timeout for "create" cannot be parsed, relative timeout "default-30m" resolves to -10m0s, which is not greater than zero, with default 20m0s`,
+				),
+			},
+		},
+		"create-none": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create": types.StringType,
+					},
+					map[string]attr.Value{
+						"create": types.StringValue("none"),
+					},
+				),
+			},
+			expectedTimeout: timeouts.NoTimeout,
+		},
 		"create-not-set": {
 			timeoutsValue: timeouts.Value{
 				Object: types.Object{},
@@ -216,7 +287,7 @@ func TestTimeoutsValueCreate(t *testing.T) {
 						"create": types.StringType,
 					},
 					map[string]attr.Value{
-						"create": types.StringValue("10x"),
+						"create": types.StringValue("10y"),
 					},
 				),
 			},
@@ -224,7 +295,7 @@ func TestTimeoutsValueCreate(t *testing.T) {
 			expectedDiags: diag.Diagnostics{
 				diag.NewErrorDiagnostic(
 					"Timeout Cannot Be Parsed",
-					`timeout for "create" cannot be parsed, time: unknown unit "x" in duration "10x"`,
+					`timeout for "create" cannot be parsed, time: unknown unit "y" in duration "10y"`,
 				),
 			},
 		},
@@ -310,7 +381,7 @@ func TestTimeoutsValueRead(t *testing.T) {
 						"read": types.StringType,
 					},
 					map[string]attr.Value{
-						"read": types.StringValue("10x"),
+						"read": types.StringValue("10y"),
 					},
 				),
 			},
@@ -318,7 +389,7 @@ func TestTimeoutsValueRead(t *testing.T) {
 			expectedDiags: diag.Diagnostics{
 				diag.NewErrorDiagnostic(
 					"Timeout Cannot Be Parsed",
-					`timeout for "read" cannot be parsed, time: unknown unit "x" in duration "10x"`,
+					`timeout for "read" cannot be parsed, time: unknown unit "y" in duration "10y"`,
 				),
 			},
 		},
@@ -404,7 +475,7 @@ func TestTimeoutsValueUpdate(t *testing.T) {
 						"update": types.StringType,
 					},
 					map[string]attr.Value{
-						"update": types.StringValue("10x"),
+						"update": types.StringValue("10y"),
 					},
 				),
 			},
@@ -412,7 +483,7 @@ func TestTimeoutsValueUpdate(t *testing.T) {
 			expectedDiags: diag.Diagnostics{
 				diag.NewErrorDiagnostic(
 					"Timeout Cannot Be Parsed",
-					`timeout for "update" cannot be parsed, time: unknown unit "x" in duration "10x"`,
+					`timeout for "update" cannot be parsed, time: unknown unit "y" in duration "10y"`,
 				),
 			},
 		},
@@ -498,7 +569,7 @@ func TestTimeoutsValueDelete(t *testing.T) {
 						"delete": types.StringType,
 					},
 					map[string]attr.Value{
-						"delete": types.StringValue("10x"),
+						"delete": types.StringValue("10y"),
 					},
 				),
 			},
@@ -506,7 +577,7 @@ func TestTimeoutsValueDelete(t *testing.T) {
 			expectedDiags: diag.Diagnostics{
 				diag.NewErrorDiagnostic(
 					"Timeout Cannot Be Parsed",
-					`timeout for "delete" cannot be parsed, time: unknown unit "x" in duration "10x"`,
+					`timeout for "delete" cannot be parsed, time: unknown unit "y" in duration "10y"`,
 				),
 			},
 		},
@@ -529,3 +600,107 @@ func TestTimeoutsValueDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeoutsValuePlan(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		timeoutsValue   timeouts.Value
+		expectedTimeout time.Duration
+		expectedDiags   diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"plan": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"plan": types.StringType,
+					},
+					map[string]attr.Value{
+						"plan": types.StringValue("10m"),
+					},
+				),
+			},
+			expectedTimeout: 10 * time.Minute,
+			expectedDiags:   nil,
+		},
+		"plan-not-set": {
+			timeoutsValue: timeouts.Value{
+				Object: types.Object{},
+			},
+			expectedTimeout: 20 * time.Minute,
+		},
+		"plan-null": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"plan": types.StringType,
+					},
+					map[string]attr.Value{
+						"plan": types.StringNull(),
+					},
+				),
+			},
+			expectedTimeout: 20 * time.Minute,
+		},
+		"plan-unknown": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"plan": types.StringType,
+					},
+					map[string]attr.Value{
+						"plan": types.StringUnknown(),
+					},
+				),
+			},
+			expectedTimeout: 20 * time.Minute,
+		},
+		"plan-not-parseable-as-time-duration": {
+			timeoutsValue: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"plan": types.StringType,
+					},
+					map[string]attr.Value{
+						"plan": types.StringValue("10y"),
+					},
+				),
+			},
+			expectedTimeout: 20 * time.Minute,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Timeout Cannot Be Parsed",
+					`timeout for "plan" cannot be parsed, time: unknown unit "y" in duration "10y"`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotTimeout, gotErr := test.timeoutsValue.Plan(context.Background(), 20*time.Minute)
+
+			if diff := cmp.Diff(gotTimeout, test.expectedTimeout); diff != "" {
+				t.Errorf("unexpected timeout difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotErr, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected err difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeoutsValueOperations(t *testing.T) {
+	t.Parallel()
+
+	var value timeouts.Timeouts = timeouts.Value{}
+
+	if diff := cmp.Diff(value.Operations(), []string{"create", "read", "update", "delete", "plan"}); diff != "" {
+		t.Errorf("unexpected operations difference: %s", diff)
+	}
+}
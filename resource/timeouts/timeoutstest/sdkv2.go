@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timeoutstest contains helpers for testing resources which use the
+// resource/timeouts package.
+package timeoutstest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+// blockName is the name of the timeouts block.
+const blockName = "timeouts"
+
+// SDKv2Block returns the protocol version 5 schema of the timeouts block which
+// terraform-plugin-sdk/v2 generates for a resource with the timeouts in opts. The
+// block has single nesting and contains an optional string attribute, without a
+// description, for each timeout which is set, including "default".
+func SDKv2Block(opts timeouts.SDKv2Opts) *tfprotov5.SchemaNestedBlock {
+	var attributes []*tfprotov5.SchemaAttribute
+
+	for _, name := range opts.AttributeNames() {
+		attributes = append(attributes, &tfprotov5.SchemaAttribute{
+			Name:            name,
+			Type:            tftypes.String,
+			Optional:        true,
+			DescriptionKind: tfprotov5.StringKindPlain,
+		})
+	}
+
+	return &tfprotov5.SchemaNestedBlock{
+		TypeName: blockName,
+		Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+		Block: &tfprotov5.SchemaBlock{
+			Attributes:      attributes,
+			DescriptionKind: tfprotov5.StringKindPlain,
+		},
+	}
+}
+
+// DiffSDKv2 returns a human-readable report of the differences between the
+// supplied SDKv2 timeouts block, such as one returned by SDKv2Block or read from
+// the GetProviderSchema response of the SDKv2 provider, and the protocol version
+// 5 schema of the supplied framework block, converted following the same rules
+// as the framework uses when serving the schema to Terraform.
+// An empty string is returned if the schemas are equivalent. An error is returned
+// if the framework block cannot be converted, such as when it contains nested
+// attributes, which protocol version 5 does not support.
+func DiffSDKv2(ctx context.Context, block schema.Block, sdkv2Block *tfprotov5.SchemaNestedBlock) (string, error) {
+	frameworkBlock, err := protocolBlock(ctx, blockName, block)
+	if err != nil {
+		return "", err
+	}
+
+	return cmp.Diff(sdkv2Block, frameworkBlock), nil
+}
+
+// protocolAttribute is implemented by every resource schema attribute, and
+// describes the attribute as served to Terraform.
+type protocolAttribute interface {
+	GetType() attr.Type
+	GetDescription() string
+	GetMarkdownDescription() string
+	GetDeprecationMessage() string
+	IsRequired() bool
+	IsOptional() bool
+	IsComputed() bool
+	IsSensitive() bool
+	IsWriteOnly() bool
+}
+
+// protocolBlock returns the protocol version 5 schema of block, as served to
+// Terraform by the framework.
+func protocolBlock(ctx context.Context, name string, block schema.Block) (*tfprotov5.SchemaNestedBlock, error) {
+	var attributes map[string]schema.Attribute
+	var blocks map[string]schema.Block
+	var description, markdownDescription, deprecationMessage string
+	var nesting tfprotov5.SchemaNestedBlockNestingMode
+
+	switch b := block.(type) {
+	case schema.SingleNestedBlock:
+		attributes, blocks = b.Attributes, b.Blocks
+		description, markdownDescription, deprecationMessage = b.Description, b.MarkdownDescription, b.DeprecationMessage
+		nesting = tfprotov5.SchemaNestedBlockNestingModeSingle
+	case schema.ListNestedBlock:
+		attributes, blocks = b.NestedObject.Attributes, b.NestedObject.Blocks
+		description, markdownDescription, deprecationMessage = b.Description, b.MarkdownDescription, b.DeprecationMessage
+		nesting = tfprotov5.SchemaNestedBlockNestingModeList
+	case schema.SetNestedBlock:
+		attributes, blocks = b.NestedObject.Attributes, b.NestedObject.Blocks
+		description, markdownDescription, deprecationMessage = b.Description, b.MarkdownDescription, b.DeprecationMessage
+		nesting = tfprotov5.SchemaNestedBlockNestingModeSet
+	default:
+		return nil, fmt.Errorf("block %q of type %T is not supported", name, block)
+	}
+
+	nestedBlock := &tfprotov5.SchemaNestedBlock{
+		TypeName: name,
+		Nesting:  nesting,
+		Block: &tfprotov5.SchemaBlock{
+			Deprecated:         deprecationMessage != "",
+			DeprecationMessage: deprecationMessage,
+		},
+	}
+
+	nestedBlock.Block.Description, nestedBlock.Block.DescriptionKind = protocolDescription(description, markdownDescription)
+
+	for attributeName, attribute := range attributes {
+		switch attribute.(type) {
+		case schema.SingleNestedAttribute, schema.ListNestedAttribute, schema.SetNestedAttribute, schema.MapNestedAttribute:
+			return nil, fmt.Errorf("attribute %q is a nested attribute, which protocol version 5 does not support", attributeName)
+		}
+
+		a, ok := attribute.(protocolAttribute)
+		if !ok {
+			return nil, fmt.Errorf("attribute %q of type %T is not supported", attributeName, attribute)
+		}
+
+		schemaAttribute := &tfprotov5.SchemaAttribute{
+			Name:               attributeName,
+			Type:               a.GetType().TerraformType(ctx),
+			Required:           a.IsRequired(),
+			Optional:           a.IsOptional(),
+			Computed:           a.IsComputed(),
+			Sensitive:          a.IsSensitive(),
+			WriteOnly:          a.IsWriteOnly(),
+			Deprecated:         a.GetDeprecationMessage() != "",
+			DeprecationMessage: a.GetDeprecationMessage(),
+		}
+
+		schemaAttribute.Description, schemaAttribute.DescriptionKind = protocolDescription(a.GetDescription(), a.GetMarkdownDescription())
+
+		nestedBlock.Block.Attributes = append(nestedBlock.Block.Attributes, schemaAttribute)
+	}
+
+	for blockName, b := range blocks {
+		blockType, err := protocolBlock(ctx, blockName, b)
+		if err != nil {
+			return nil, err
+		}
+
+		nestedBlock.Block.BlockTypes = append(nestedBlock.Block.BlockTypes, blockType)
+	}
+
+	sort.Slice(nestedBlock.Block.Attributes, func(i, j int) bool {
+		return nestedBlock.Block.Attributes[i].Name < nestedBlock.Block.Attributes[j].Name
+	})
+
+	sort.Slice(nestedBlock.Block.BlockTypes, func(i, j int) bool {
+		return nestedBlock.Block.BlockTypes[i].TypeName < nestedBlock.Block.BlockTypes[j].TypeName
+	})
+
+	return nestedBlock, nil
+}
+
+// protocolDescription returns the description served to Terraform, which is the
+// Markdown description if set.
+func protocolDescription(description string, markdownDescription string) (string, tfprotov5.StringKind) {
+	if markdownDescription != "" {
+		return markdownDescription, tfprotov5.StringKindMarkdown
+	}
+
+	return description, tfprotov5.StringKindPlain
+}
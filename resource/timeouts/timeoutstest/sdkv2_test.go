@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeoutstest_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts/timeoutstest"
+)
+
+func TestDiffSDKv2(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type testCase struct {
+		block        schema.Block
+		opts         timeouts.SDKv2Opts
+		expectedDiff bool
+		expectedErr  string
+	}
+	tests := map[string]testCase{
+		"sdkv2": {
+			block: timeouts.BlockSDKv2(ctx, timeouts.SDKv2Opts{
+				Create:  true,
+				Delete:  true,
+				Default: true,
+			}),
+			opts: timeouts.SDKv2Opts{
+				Create:  true,
+				Delete:  true,
+				Default: true,
+			},
+		},
+		"sdkv2-different-attributes": {
+			block: timeouts.BlockSDKv2(ctx, timeouts.SDKv2Opts{
+				Create: true,
+			}),
+			opts: timeouts.SDKv2Opts{
+				Create:  true,
+				Default: true,
+			},
+			expectedDiff: true,
+		},
+		"descriptions": {
+			block: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+			opts: timeouts.SDKv2Opts{
+				Create: true,
+			},
+			expectedDiff: true,
+		},
+		"nested-attribute": {
+			block: schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"create": schema.SingleNestedAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expectedErr: `attribute "create" is a nested attribute, which protocol version 5 does not support`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diff, err := timeoutstest.DiffSDKv2(ctx, test.block, timeoutstest.SDKv2Block(test.opts))
+
+			var gotErr string
+			if err != nil {
+				gotErr = err.Error()
+			}
+
+			if gotErr != test.expectedErr {
+				t.Fatalf("expected error %q, got %q", test.expectedErr, gotErr)
+			}
+
+			if gotDiff := diff != ""; gotDiff != test.expectedDiff {
+				t.Errorf("expected difference %t, got diff: %s", test.expectedDiff, diff)
+			}
+
+			if test.expectedDiff && !strings.Contains(diff, "Attributes") {
+				t.Errorf("expected attributes difference, got: %s", diff)
+			}
+		})
+	}
+}
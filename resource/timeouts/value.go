@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NewValue returns a known Value with an attribute for each of the operations in
+// opts which are set to true. Attributes keyed by name in timeouts, such as
+// "create", are set to the duration formatted as a string, or "none" for
+// NoTimeout, and all other attributes are null, including the "deadline"
+// attribute if enabled. An error diagnostic is returned, along with an unknown
+// Value, if timeouts contains an operation which is not enabled in opts, a key
+// which is not an operation, such as "deadline", which is not a duration, or
+// NoTimeout when opts.AllowNoTimeout is false, as the schema would reject it.
+func NewValue(opts Opts, timeouts map[string]time.Duration) (Value, diag.Diagnostics) {
+	c := core
+	c.AllowNone = opts.AllowNoTimeout
+
+	return c.NewValue(attrTypesMap(opts), timeouts)
+}
+
+// NewValueMust is the same as NewValue, but panics if any error diagnostics are
+// generated. It is intended for use in tests.
+func NewValueMust(opts Opts, timeouts map[string]time.Duration) Value {
+	value, diags := NewValue(opts, timeouts)
+	if diags.HasError() {
+		panic(fmt.Sprintf("NewValueMust received error diagnostics: %v", diags))
+	}
+
+	return value
+}
+
+// NullValue returns a null Value with an attribute for each of the operations in
+// opts which are set to true, such as when the timeouts block or attribute is not
+// configured.
+func NullValue(opts Opts) Value {
+	return Value{
+		Object: types.ObjectNull(attrTypesMap(opts)),
+	}
+}
+
+// UnknownValue returns an unknown Value with an attribute for each of the
+// operations in opts which are set to true.
+func UnknownValue(opts Opts) Value {
+	return Value{
+		Object: types.ObjectUnknown(attrTypesMap(opts)),
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func TestNewValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		opts          timeouts.Opts
+		timeouts      map[string]time.Duration
+		expected      timeouts.Value
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"create-update": {
+			opts: timeouts.Opts{
+				Create:   true,
+				Update:   true,
+				Deadline: true,
+			},
+			timeouts: map[string]time.Duration{
+				"create": 30 * time.Minute,
+			},
+			expected: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"create":   types.StringType,
+						"update":   types.StringType,
+						"deadline": types.StringType,
+					},
+					map[string]attr.Value{
+						"create":   types.StringValue("30m0s"),
+						"update":   types.StringNull(),
+						"deadline": types.StringNull(),
+					},
+				),
+			},
+		},
+		"no-timeout": {
+			opts: timeouts.Opts{
+				Delete:         true,
+				AllowNoTimeout: true,
+			},
+			timeouts: map[string]time.Duration{
+				"delete": timeouts.NoTimeout,
+			},
+			expected: timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{
+						"delete": types.StringType,
+					},
+					map[string]attr.Value{
+						"delete": types.StringValue("none"),
+					},
+				),
+			},
+		},
+		"no-timeout-not-allowed": {
+			opts: timeouts.Opts{
+				Delete: true,
+			},
+			timeouts: map[string]time.Duration{
+				"delete": timeouts.NoTimeout,
+			},
+			expected: timeouts.UnknownValue(timeouts.Opts{
+				Delete: true,
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Timeouts Value",
+					`timeout for "delete" cannot be set, "none" is not supported`,
+				),
+			},
+		},
+		"not-enabled": {
+			opts: timeouts.Opts{
+				Create: true,
+			},
+			timeouts: map[string]time.Duration{
+				"create": 30 * time.Minute,
+				"read":   time.Minute,
+			},
+			expected: timeouts.UnknownValue(timeouts.Opts{
+				Create: true,
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Timeouts Value",
+					`timeout for "read" cannot be set, the operation is not supported by the timeouts attributes`,
+				),
+			},
+		},
+		"deadline": {
+			opts: timeouts.Opts{
+				Create:   true,
+				Deadline: true,
+			},
+			timeouts: map[string]time.Duration{
+				"deadline": time.Hour,
+			},
+			expected: timeouts.UnknownValue(timeouts.Opts{
+				Create:   true,
+				Deadline: true,
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Timeouts Value",
+					`timeout for "deadline" cannot be set, it is not an operation, expected one of ["create" "read" "update" "delete" "plan"]`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := timeouts.NewValue(test.opts, test.timeouts)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, test.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewValueMustAccessors(t *testing.T) {
+	t.Parallel()
+
+	value := timeouts.NewValueMust(timeouts.Opts{Create: true, Read: true}, map[string]time.Duration{
+		"create": 30 * time.Minute,
+	})
+
+	gotCreate, diags := value.Create(context.Background(), 20*time.Minute)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if gotCreate != 30*time.Minute {
+		t.Errorf("expected create timeout %s, got %s", 30*time.Minute, gotCreate)
+	}
+
+	gotRead, _ := value.Read(context.Background(), 20*time.Minute)
+
+	if gotRead != 20*time.Minute {
+		t.Errorf("expected read timeout %s, got %s", 20*time.Minute, gotRead)
+	}
+}
+
+func TestNullValue(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.NullValue(timeouts.Opts{Create: true})
+
+	expected := timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+		}),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+
+	expectedType := timeouts.Block(context.Background(), timeouts.Opts{Create: true}).Type()
+
+	if !got.Type(context.Background()).Equal(expectedType) {
+		t.Errorf("expected type %s, got %s", expectedType, got.Type(context.Background()))
+	}
+}
+
+func TestUnknownValue(t *testing.T) {
+	t.Parallel()
+
+	got := timeouts.UnknownValue(timeouts.Opts{Create: true})
+
+	expected := timeouts.Value{
+		Object: types.ObjectUnknown(map[string]attr.Type{
+			"create": types.StringType,
+		}),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+}
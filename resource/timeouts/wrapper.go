@@ -0,0 +1,377 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutdiag"
+)
+
+var (
+	_ resource.ResourceWithConfigure        = &wrappedResource{}
+	_ resource.ResourceWithConfigValidators = &wrappedResource{}
+	_ resource.ResourceWithValidateConfig   = &wrappedResource{}
+
+	_ resource.ResourceWithModifyPlan = struct {
+		*wrappedResource
+		resourceModifyPlan
+	}{}
+	_ resource.ResourceWithImportState = struct {
+		*wrappedResource
+		resourceImportState
+	}{}
+	_ resource.ResourceWithMoveState = struct {
+		*wrappedResource
+		resourceMoveState
+	}{}
+	_ resource.ResourceWithUpgradeState = struct {
+		*wrappedResource
+		resourceUpgradeState
+	}{}
+	_ resource.ResourceWithUpgradeIdentity = struct {
+		*wrappedResource
+		resourceIdentity
+	}{}
+)
+
+// The optional resource interfaces which are only implemented by the wrapper
+// when implemented by the wrapped resource, as the framework behaves differently
+// for resources which implement them. Each has a capability type implementing
+// it, listed in the same order in the go:generate directive below, which
+// generates combineWrappedResource to compose them. Adding an interface only
+// requires a constant, a capability type and an entry in the directive.
+//
+//go:generate go run ../../internal/cmd/gencombinations -output wrapper_combinations.go -package timeouts -function combineWrappedResource -base wrappedResource -result resource.Resource -import github.com/hashicorp/terraform-plugin-framework/resource resourceModifyPlan resourceImportState resourceMoveState resourceUpgradeState resourceIdentity
+const (
+	wrapsModifyPlan = 1 << iota
+	wrapsImportState
+	wrapsMoveState
+	wrapsUpgradeState
+	wrapsIdentity
+)
+
+// WrapResource returns a resource.Resource which enforces the timeouts of the
+// wrapped resource, so that they do not need to be applied within every method.
+//
+// The "timeouts" attribute or block at the root of the schema is read from the
+// plan for Create, Update and ModifyPlan, and from the prior state for Read and
+// Delete. The context passed to the wrapped method is cancelled when the timeout
+// for the operation, or the "deadline" attribute, is reached, as for the Value
+// context accessors such as CreateContext. ImportState is limited by the timeout
+// returned by ImportContext. If the context times out and the wrapped method
+// returns error diagnostics, the standard diagnostic returned by
+// DeadlineExceededDiagnostic is added.
+//
+// The defaults supply the default timeout for each operation, which are replaced
+// by any provider or module defaults as for the Value accessors. Operations with a
+// zero default have no timeout unless one is configured. Resources without a
+// "timeouts" attribute or block use the defaults.
+//
+// The returned resource implements each optional resource interface, such as
+// resource.ResourceWithImportState and resource.ResourceWithUpgradeState, only if
+// the wrapped resource implements it, so that the framework handles resources
+// which do not implement an interface as it would if they were not wrapped.
+func WrapResource(r resource.Resource, defaults Defaults) resource.Resource {
+	w := &wrappedResource{
+		Resource: r,
+		defaults: defaults,
+	}
+
+	var wraps int
+
+	if _, ok := r.(resource.ResourceWithModifyPlan); ok {
+		wraps |= wrapsModifyPlan
+	}
+
+	if _, ok := r.(resource.ResourceWithImportState); ok {
+		wraps |= wrapsImportState
+	}
+
+	if _, ok := r.(resource.ResourceWithMoveState); ok {
+		wraps |= wrapsMoveState
+	}
+
+	if _, ok := r.(resource.ResourceWithUpgradeState); ok {
+		wraps |= wrapsUpgradeState
+	}
+
+	if _, ok := r.(resource.ResourceWithIdentity); ok {
+		wraps |= wrapsIdentity
+	}
+
+	return combineWrappedResource(w, wraps)
+}
+
+// wrappedResource applies timeouts to the CRUD methods of a resource.Resource.
+type wrappedResource struct {
+	resource.Resource
+
+	defaults Defaults
+}
+
+// Create calls the wrapped Create with a context limited by the "create" timeout.
+func (w *wrappedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := w.context(ctx, req.Plan.Raw.IsNull(), req.Plan.Schema, req.Plan.GetAttribute, attributeNameCreate, w.defaults.Create)
+	defer cancel()
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	w.Resource.Create(ctx, req, resp)
+
+	appendDeadlineExceeded(ctx, req.Plan.Schema, &resp.Diagnostics)
+}
+
+// Read calls the wrapped Read with a context limited by the "read" timeout.
+func (w *wrappedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := w.context(ctx, req.State.Raw.IsNull(), req.State.Schema, req.State.GetAttribute, attributeNameRead, w.defaults.Read)
+	defer cancel()
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	w.Resource.Read(ctx, req, resp)
+
+	appendDeadlineExceeded(ctx, req.State.Schema, &resp.Diagnostics)
+}
+
+// Update calls the wrapped Update with a context limited by the "update" timeout.
+func (w *wrappedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := w.context(ctx, req.Plan.Raw.IsNull(), req.Plan.Schema, req.Plan.GetAttribute, attributeNameUpdate, w.defaults.Update)
+	defer cancel()
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	w.Resource.Update(ctx, req, resp)
+
+	appendDeadlineExceeded(ctx, req.Plan.Schema, &resp.Diagnostics)
+}
+
+// Delete calls the wrapped Delete with a context limited by the "delete" timeout.
+func (w *wrappedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := w.context(ctx, req.State.Raw.IsNull(), req.State.Schema, req.State.GetAttribute, attributeNameDelete, w.defaults.Delete)
+	defer cancel()
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	w.Resource.Delete(ctx, req, resp)
+
+	appendDeadlineExceeded(ctx, req.State.Schema, &resp.Diagnostics)
+}
+
+// Configure calls the wrapped Configure, if implemented.
+func (w *wrappedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if r, ok := w.Resource.(resource.ResourceWithConfigure); ok {
+		r.Configure(ctx, req, resp)
+	}
+}
+
+// ConfigValidators returns the wrapped ConfigValidators, if implemented.
+func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if r, ok := w.Resource.(resource.ResourceWithConfigValidators); ok {
+		return r.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+// ValidateConfig calls the wrapped ValidateConfig, if implemented.
+func (w *wrappedResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if r, ok := w.Resource.(resource.ResourceWithValidateConfig); ok {
+		r.ValidateConfig(ctx, req, resp)
+	}
+}
+
+// Unwrap returns the wrapped resource.
+func (w *wrappedResource) Unwrap() resource.Resource {
+	return w.Resource
+}
+
+// timeoutsSchema is implemented by the Schema of a plan or state.
+type timeoutsSchema interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// context returns a copy of ctx limited by the named timeout, reading the
+// timeouts from the plan or state if it is not null and its schema, which may be
+// nil, defines them.
+func (w *wrappedResource) context(ctx context.Context, null bool, s timeoutsSchema, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics, timeoutName string, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	var value Value
+	var diags diag.Diagnostics
+
+	timeoutsPath := path.Root("timeouts")
+
+	if !null && s != nil {
+		if _, d := s.TypeAtPath(ctx, timeoutsPath); !d.HasError() {
+			value, diags = core.ValueAtPath(ctx, getAttribute, timeoutsPath)
+		}
+	}
+
+	ctx, cancel, d := value.getContext(ctx, timeoutName, defaultOrNoTimeout(defaultTimeout))
+	diags.Append(d...)
+
+	return ctx, cancel, diags
+}
+
+// resourceModifyPlan implements resource.ResourceWithModifyPlan for wrapped
+// resources which implement it.
+type resourceModifyPlan struct {
+	w *wrappedResource
+}
+
+// ModifyPlan calls the wrapped ModifyPlan with a context limited by the "plan"
+// timeout.
+func (m resourceModifyPlan) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, cancel, diags := m.w.context(ctx, req.Plan.Raw.IsNull(), req.Plan.Schema, req.Plan.GetAttribute, attributeNamePlan, m.w.defaults.Plan)
+	defer cancel()
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//nolint:forcetypeassert // WrapResource only embeds this type for resources which implement it.
+	m.w.Resource.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, resp)
+
+	appendDeadlineExceeded(ctx, req.Plan.Schema, &resp.Diagnostics)
+}
+
+// resourceImportState implements resource.ResourceWithImportState for wrapped
+// resources which implement it.
+type resourceImportState struct {
+	w *wrappedResource
+}
+
+// ImportState calls the wrapped ImportState with a context limited by the import
+// timeout.
+func (i resourceImportState) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, cancel, diags := ImportContext(ctx, defaultOrNoTimeout(i.w.defaults.Import))
+	defer cancel()
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//nolint:forcetypeassert // WrapResource only embeds this type for resources which implement it.
+	i.w.Resource.(resource.ResourceWithImportState).ImportState(ctx, req, resp)
+
+	appendDeadlineExceeded(ctx, nil, &resp.Diagnostics)
+}
+
+// resourceMoveState implements resource.ResourceWithMoveState for wrapped
+// resources which implement it.
+type resourceMoveState struct {
+	w *wrappedResource
+}
+
+// MoveState returns the wrapped MoveState.
+func (m resourceMoveState) MoveState(ctx context.Context) []resource.StateMover {
+	//nolint:forcetypeassert // WrapResource only embeds this type for resources which implement it.
+	return m.w.Resource.(resource.ResourceWithMoveState).MoveState(ctx)
+}
+
+// resourceUpgradeState implements resource.ResourceWithUpgradeState for wrapped
+// resources which implement it.
+type resourceUpgradeState struct {
+	w *wrappedResource
+}
+
+// UpgradeState returns the wrapped UpgradeState.
+func (u resourceUpgradeState) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	//nolint:forcetypeassert // WrapResource only embeds this type for resources which implement it.
+	return u.w.Resource.(resource.ResourceWithUpgradeState).UpgradeState(ctx)
+}
+
+// resourceIdentity implements the identity interfaces for wrapped resources which
+// implement resource.ResourceWithIdentity, as the framework treats any resource
+// implementing it as supporting identity.
+type resourceIdentity struct {
+	w *wrappedResource
+}
+
+// IdentitySchema calls the wrapped IdentitySchema.
+func (i resourceIdentity) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	//nolint:forcetypeassert // WrapResource only embeds this type for resources with identity.
+	i.w.Resource.(resource.ResourceWithIdentity).IdentitySchema(ctx, req, resp)
+}
+
+// UpgradeIdentity returns the wrapped UpgradeIdentity, if implemented.
+func (i resourceIdentity) UpgradeIdentity(ctx context.Context) map[int64]resource.IdentityUpgrader {
+	if r, ok := i.w.Resource.(resource.ResourceWithUpgradeIdentity); ok {
+		return r.UpgradeIdentity(ctx)
+	}
+
+	return nil
+}
+
+// appendDeadlineExceeded adds the standard timeout diagnostic if ctx has timed
+// out and diags contains an error, which was then most likely caused by the
+// timeout. The snippet in the diagnostic uses the syntax of the timeouts in the
+// resource schema, if known.
+func appendDeadlineExceeded(ctx context.Context, resourceSchema any, diags *diag.Diagnostics) {
+	if !diags.HasError() {
+		return
+	}
+
+	timeoutErr, ok := timeoutctx.FromError(ctx, ctx.Err())
+	if !ok {
+		return
+	}
+
+	diags.Append(timeoutdiag.Diagnostic(timeoutErr, path.Root("timeouts"), timeoutsSyntax(resourceSchema)))
+}
+
+// timeoutsSyntax returns whether the "timeouts" of resourceSchema, which is the
+// Schema of a plan or state, are a block or an attribute.
+func timeoutsSyntax(resourceSchema any) timeoutdiag.Syntax {
+	s, ok := resourceSchema.(schema.Schema)
+	if !ok {
+		return timeoutdiag.SyntaxUnknown
+	}
+
+	if _, ok := s.Blocks["timeouts"]; ok {
+		return timeoutdiag.SyntaxBlock
+	}
+
+	if _, ok := s.Attributes["timeouts"]; ok {
+		return timeoutdiag.SyntaxAttribute
+	}
+
+	return timeoutdiag.SyntaxUnknown
+}
+
+// defaultOrNoTimeout returns NoTimeout for a zero default timeout.
+func defaultOrNoTimeout(defaultTimeout time.Duration) time.Duration {
+	if defaultTimeout == 0 {
+		return NoTimeout
+	}
+
+	return defaultTimeout
+}
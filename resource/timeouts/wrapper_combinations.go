@@ -0,0 +1,369 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by gencombinations; DO NOT EDIT.
+
+package timeouts
+
+import "github.com/hashicorp/terraform-plugin-framework/resource"
+
+// combineWrappedResource returns base composed with the capability types
+// selected by wraps, in which bit i selects the i-th of:
+//
+//   - resourceModifyPlan
+//   - resourceImportState
+//   - resourceMoveState
+//   - resourceUpgradeState
+//   - resourceIdentity
+func combineWrappedResource(base *wrappedResource, wraps int) resource.Resource {
+	switch wraps {
+	case 0b00001:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+		}{
+			base,
+			resourceModifyPlan{base},
+		}
+	case 0b00010:
+		return struct {
+			*wrappedResource
+			resourceImportState
+		}{
+			base,
+			resourceImportState{base},
+		}
+	case 0b00011:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceImportState
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceImportState{base},
+		}
+	case 0b00100:
+		return struct {
+			*wrappedResource
+			resourceMoveState
+		}{
+			base,
+			resourceMoveState{base},
+		}
+	case 0b00101:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceMoveState
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceMoveState{base},
+		}
+	case 0b00110:
+		return struct {
+			*wrappedResource
+			resourceImportState
+			resourceMoveState
+		}{
+			base,
+			resourceImportState{base},
+			resourceMoveState{base},
+		}
+	case 0b00111:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceImportState
+			resourceMoveState
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceImportState{base},
+			resourceMoveState{base},
+		}
+	case 0b01000:
+		return struct {
+			*wrappedResource
+			resourceUpgradeState
+		}{
+			base,
+			resourceUpgradeState{base},
+		}
+	case 0b01001:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceUpgradeState
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceUpgradeState{base},
+		}
+	case 0b01010:
+		return struct {
+			*wrappedResource
+			resourceImportState
+			resourceUpgradeState
+		}{
+			base,
+			resourceImportState{base},
+			resourceUpgradeState{base},
+		}
+	case 0b01011:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceImportState
+			resourceUpgradeState
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceImportState{base},
+			resourceUpgradeState{base},
+		}
+	case 0b01100:
+		return struct {
+			*wrappedResource
+			resourceMoveState
+			resourceUpgradeState
+		}{
+			base,
+			resourceMoveState{base},
+			resourceUpgradeState{base},
+		}
+	case 0b01101:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceMoveState
+			resourceUpgradeState
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceMoveState{base},
+			resourceUpgradeState{base},
+		}
+	case 0b01110:
+		return struct {
+			*wrappedResource
+			resourceImportState
+			resourceMoveState
+			resourceUpgradeState
+		}{
+			base,
+			resourceImportState{base},
+			resourceMoveState{base},
+			resourceUpgradeState{base},
+		}
+	case 0b01111:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceImportState
+			resourceMoveState
+			resourceUpgradeState
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceImportState{base},
+			resourceMoveState{base},
+			resourceUpgradeState{base},
+		}
+	case 0b10000:
+		return struct {
+			*wrappedResource
+			resourceIdentity
+		}{
+			base,
+			resourceIdentity{base},
+		}
+	case 0b10001:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceIdentity
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceIdentity{base},
+		}
+	case 0b10010:
+		return struct {
+			*wrappedResource
+			resourceImportState
+			resourceIdentity
+		}{
+			base,
+			resourceImportState{base},
+			resourceIdentity{base},
+		}
+	case 0b10011:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceImportState
+			resourceIdentity
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceImportState{base},
+			resourceIdentity{base},
+		}
+	case 0b10100:
+		return struct {
+			*wrappedResource
+			resourceMoveState
+			resourceIdentity
+		}{
+			base,
+			resourceMoveState{base},
+			resourceIdentity{base},
+		}
+	case 0b10101:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceMoveState
+			resourceIdentity
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceMoveState{base},
+			resourceIdentity{base},
+		}
+	case 0b10110:
+		return struct {
+			*wrappedResource
+			resourceImportState
+			resourceMoveState
+			resourceIdentity
+		}{
+			base,
+			resourceImportState{base},
+			resourceMoveState{base},
+			resourceIdentity{base},
+		}
+	case 0b10111:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceImportState
+			resourceMoveState
+			resourceIdentity
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceImportState{base},
+			resourceMoveState{base},
+			resourceIdentity{base},
+		}
+	case 0b11000:
+		return struct {
+			*wrappedResource
+			resourceUpgradeState
+			resourceIdentity
+		}{
+			base,
+			resourceUpgradeState{base},
+			resourceIdentity{base},
+		}
+	case 0b11001:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceUpgradeState
+			resourceIdentity
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceUpgradeState{base},
+			resourceIdentity{base},
+		}
+	case 0b11010:
+		return struct {
+			*wrappedResource
+			resourceImportState
+			resourceUpgradeState
+			resourceIdentity
+		}{
+			base,
+			resourceImportState{base},
+			resourceUpgradeState{base},
+			resourceIdentity{base},
+		}
+	case 0b11011:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceImportState
+			resourceUpgradeState
+			resourceIdentity
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceImportState{base},
+			resourceUpgradeState{base},
+			resourceIdentity{base},
+		}
+	case 0b11100:
+		return struct {
+			*wrappedResource
+			resourceMoveState
+			resourceUpgradeState
+			resourceIdentity
+		}{
+			base,
+			resourceMoveState{base},
+			resourceUpgradeState{base},
+			resourceIdentity{base},
+		}
+	case 0b11101:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceMoveState
+			resourceUpgradeState
+			resourceIdentity
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceMoveState{base},
+			resourceUpgradeState{base},
+			resourceIdentity{base},
+		}
+	case 0b11110:
+		return struct {
+			*wrappedResource
+			resourceImportState
+			resourceMoveState
+			resourceUpgradeState
+			resourceIdentity
+		}{
+			base,
+			resourceImportState{base},
+			resourceMoveState{base},
+			resourceUpgradeState{base},
+			resourceIdentity{base},
+		}
+	case 0b11111:
+		return struct {
+			*wrappedResource
+			resourceModifyPlan
+			resourceImportState
+			resourceMoveState
+			resourceUpgradeState
+			resourceIdentity
+		}{
+			base,
+			resourceModifyPlan{base},
+			resourceImportState{base},
+			resourceMoveState{base},
+			resourceUpgradeState{base},
+			resourceIdentity{base},
+		}
+	}
+
+	return base
+}
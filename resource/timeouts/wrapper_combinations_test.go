@@ -0,0 +1,369 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by gencombinations; DO NOT EDIT.
+
+package timeouts_test
+
+import "github.com/hashicorp/terraform-plugin-framework/resource"
+
+// combineTestResource returns base composed with the capability types
+// selected by wraps, in which bit i selects the i-th of:
+//
+//   - testModifyPlan
+//   - testImportState
+//   - testMoveState
+//   - testUpgradeState
+//   - testIdentity
+func combineTestResource(base *testResource, wraps int) resource.Resource {
+	switch wraps {
+	case 0b00001:
+		return struct {
+			*testResource
+			testModifyPlan
+		}{
+			base,
+			testModifyPlan{base},
+		}
+	case 0b00010:
+		return struct {
+			*testResource
+			testImportState
+		}{
+			base,
+			testImportState{base},
+		}
+	case 0b00011:
+		return struct {
+			*testResource
+			testModifyPlan
+			testImportState
+		}{
+			base,
+			testModifyPlan{base},
+			testImportState{base},
+		}
+	case 0b00100:
+		return struct {
+			*testResource
+			testMoveState
+		}{
+			base,
+			testMoveState{base},
+		}
+	case 0b00101:
+		return struct {
+			*testResource
+			testModifyPlan
+			testMoveState
+		}{
+			base,
+			testModifyPlan{base},
+			testMoveState{base},
+		}
+	case 0b00110:
+		return struct {
+			*testResource
+			testImportState
+			testMoveState
+		}{
+			base,
+			testImportState{base},
+			testMoveState{base},
+		}
+	case 0b00111:
+		return struct {
+			*testResource
+			testModifyPlan
+			testImportState
+			testMoveState
+		}{
+			base,
+			testModifyPlan{base},
+			testImportState{base},
+			testMoveState{base},
+		}
+	case 0b01000:
+		return struct {
+			*testResource
+			testUpgradeState
+		}{
+			base,
+			testUpgradeState{base},
+		}
+	case 0b01001:
+		return struct {
+			*testResource
+			testModifyPlan
+			testUpgradeState
+		}{
+			base,
+			testModifyPlan{base},
+			testUpgradeState{base},
+		}
+	case 0b01010:
+		return struct {
+			*testResource
+			testImportState
+			testUpgradeState
+		}{
+			base,
+			testImportState{base},
+			testUpgradeState{base},
+		}
+	case 0b01011:
+		return struct {
+			*testResource
+			testModifyPlan
+			testImportState
+			testUpgradeState
+		}{
+			base,
+			testModifyPlan{base},
+			testImportState{base},
+			testUpgradeState{base},
+		}
+	case 0b01100:
+		return struct {
+			*testResource
+			testMoveState
+			testUpgradeState
+		}{
+			base,
+			testMoveState{base},
+			testUpgradeState{base},
+		}
+	case 0b01101:
+		return struct {
+			*testResource
+			testModifyPlan
+			testMoveState
+			testUpgradeState
+		}{
+			base,
+			testModifyPlan{base},
+			testMoveState{base},
+			testUpgradeState{base},
+		}
+	case 0b01110:
+		return struct {
+			*testResource
+			testImportState
+			testMoveState
+			testUpgradeState
+		}{
+			base,
+			testImportState{base},
+			testMoveState{base},
+			testUpgradeState{base},
+		}
+	case 0b01111:
+		return struct {
+			*testResource
+			testModifyPlan
+			testImportState
+			testMoveState
+			testUpgradeState
+		}{
+			base,
+			testModifyPlan{base},
+			testImportState{base},
+			testMoveState{base},
+			testUpgradeState{base},
+		}
+	case 0b10000:
+		return struct {
+			*testResource
+			testIdentity
+		}{
+			base,
+			testIdentity{base},
+		}
+	case 0b10001:
+		return struct {
+			*testResource
+			testModifyPlan
+			testIdentity
+		}{
+			base,
+			testModifyPlan{base},
+			testIdentity{base},
+		}
+	case 0b10010:
+		return struct {
+			*testResource
+			testImportState
+			testIdentity
+		}{
+			base,
+			testImportState{base},
+			testIdentity{base},
+		}
+	case 0b10011:
+		return struct {
+			*testResource
+			testModifyPlan
+			testImportState
+			testIdentity
+		}{
+			base,
+			testModifyPlan{base},
+			testImportState{base},
+			testIdentity{base},
+		}
+	case 0b10100:
+		return struct {
+			*testResource
+			testMoveState
+			testIdentity
+		}{
+			base,
+			testMoveState{base},
+			testIdentity{base},
+		}
+	case 0b10101:
+		return struct {
+			*testResource
+			testModifyPlan
+			testMoveState
+			testIdentity
+		}{
+			base,
+			testModifyPlan{base},
+			testMoveState{base},
+			testIdentity{base},
+		}
+	case 0b10110:
+		return struct {
+			*testResource
+			testImportState
+			testMoveState
+			testIdentity
+		}{
+			base,
+			testImportState{base},
+			testMoveState{base},
+			testIdentity{base},
+		}
+	case 0b10111:
+		return struct {
+			*testResource
+			testModifyPlan
+			testImportState
+			testMoveState
+			testIdentity
+		}{
+			base,
+			testModifyPlan{base},
+			testImportState{base},
+			testMoveState{base},
+			testIdentity{base},
+		}
+	case 0b11000:
+		return struct {
+			*testResource
+			testUpgradeState
+			testIdentity
+		}{
+			base,
+			testUpgradeState{base},
+			testIdentity{base},
+		}
+	case 0b11001:
+		return struct {
+			*testResource
+			testModifyPlan
+			testUpgradeState
+			testIdentity
+		}{
+			base,
+			testModifyPlan{base},
+			testUpgradeState{base},
+			testIdentity{base},
+		}
+	case 0b11010:
+		return struct {
+			*testResource
+			testImportState
+			testUpgradeState
+			testIdentity
+		}{
+			base,
+			testImportState{base},
+			testUpgradeState{base},
+			testIdentity{base},
+		}
+	case 0b11011:
+		return struct {
+			*testResource
+			testModifyPlan
+			testImportState
+			testUpgradeState
+			testIdentity
+		}{
+			base,
+			testModifyPlan{base},
+			testImportState{base},
+			testUpgradeState{base},
+			testIdentity{base},
+		}
+	case 0b11100:
+		return struct {
+			*testResource
+			testMoveState
+			testUpgradeState
+			testIdentity
+		}{
+			base,
+			testMoveState{base},
+			testUpgradeState{base},
+			testIdentity{base},
+		}
+	case 0b11101:
+		return struct {
+			*testResource
+			testModifyPlan
+			testMoveState
+			testUpgradeState
+			testIdentity
+		}{
+			base,
+			testModifyPlan{base},
+			testMoveState{base},
+			testUpgradeState{base},
+			testIdentity{base},
+		}
+	case 0b11110:
+		return struct {
+			*testResource
+			testImportState
+			testMoveState
+			testUpgradeState
+			testIdentity
+		}{
+			base,
+			testImportState{base},
+			testMoveState{base},
+			testUpgradeState{base},
+			testIdentity{base},
+		}
+	case 0b11111:
+		return struct {
+			*testResource
+			testModifyPlan
+			testImportState
+			testMoveState
+			testUpgradeState
+			testIdentity
+		}{
+			base,
+			testModifyPlan{base},
+			testImportState{base},
+			testMoveState{base},
+			testUpgradeState{base},
+			testIdentity{base},
+		}
+	}
+
+	return base
+}
@@ -0,0 +1,440 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+// testResource records the deadline of the context passed to each method, and
+// optionally waits for the context to be done and returns an error.
+type testResource struct {
+	deadlines map[string]time.Duration
+	wait      bool
+}
+
+func (r *testResource) record(ctx context.Context, name string, diags *diag.Diagnostics) {
+	if deadline, ok := ctx.Deadline(); ok {
+		r.deadlines[name] = time.Until(deadline).Round(time.Minute)
+	} else {
+		r.deadlines[name] = 0
+	}
+
+	if r.wait {
+		<-ctx.Done()
+
+		diags.AddError("Operation Failed", ctx.Err().Error())
+	}
+}
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "test_resource"
+}
+
+func (r *testResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = testWrapperSchema(ctx)
+}
+
+func (r *testResource) Create(ctx context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+	r.record(ctx, "create", &resp.Diagnostics)
+}
+
+func (r *testResource) Read(ctx context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+	r.record(ctx, "read", &resp.Diagnostics)
+}
+
+func (r *testResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.record(ctx, "update", &resp.Diagnostics)
+}
+
+func (r *testResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.record(ctx, "delete", &resp.Diagnostics)
+}
+
+type testResourceWithImportState struct {
+	testResource
+}
+
+func (r *testResourceWithImportState) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.record(ctx, "import", &resp.Diagnostics)
+}
+
+type testResourceWithIdentity struct {
+	testResource
+}
+
+func (r *testResourceWithIdentity) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func testWrapperSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+		},
+	}
+}
+
+func testWrapperRaw(create tftypes.Value) tftypes.Value {
+	timeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+			"read":   tftypes.String,
+		},
+	}
+
+	return tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"timeouts": timeoutsType,
+			},
+		},
+		map[string]tftypes.Value{
+			"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"create": create,
+				"read":   tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	)
+}
+
+func TestWrapResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r := &testResource{
+		deadlines: map[string]time.Duration{},
+	}
+
+	wrapped := timeouts.WrapResource(r, timeouts.Defaults{
+		Create: 20 * time.Minute,
+		Read:   5 * time.Minute,
+		Delete: 30 * time.Minute,
+	})
+
+	plan := tfsdk.Plan{
+		Raw:    testWrapperRaw(tftypes.NewValue(tftypes.String, "1h")),
+		Schema: testWrapperSchema(ctx),
+	}
+	state := tfsdk.State{
+		Raw:    testWrapperRaw(tftypes.NewValue(tftypes.String, nil)),
+		Schema: testWrapperSchema(ctx),
+	}
+
+	wrapped.Create(ctx, resource.CreateRequest{Plan: plan}, &resource.CreateResponse{State: state})
+	wrapped.Read(ctx, resource.ReadRequest{State: state}, &resource.ReadResponse{State: state})
+	wrapped.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resource.UpdateResponse{State: state})
+	wrapped.Delete(ctx, resource.DeleteRequest{State: state}, &resource.DeleteResponse{State: state})
+
+	expected := map[string]time.Duration{
+		"create": time.Hour,
+		"read":   5 * time.Minute,
+		"update": 0,
+		"delete": 30 * time.Minute,
+	}
+
+	if diff := cmp.Diff(r.deadlines, expected); diff != "" {
+		t.Errorf("unexpected deadlines difference: %s", diff)
+	}
+}
+
+func TestWrapResourceWithoutTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r := &testResource{
+		deadlines: map[string]time.Duration{},
+	}
+
+	wrapped := timeouts.WrapResource(r, timeouts.Defaults{
+		Create: 20 * time.Minute,
+	})
+
+	plan := tfsdk.Plan{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "example"),
+			},
+		),
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	resp := &resource.CreateResponse{}
+
+	wrapped.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(r.deadlines, map[string]time.Duration{"create": 20 * time.Minute}); diff != "" {
+		t.Errorf("unexpected deadlines difference: %s", diff)
+	}
+}
+
+func TestWrapResourceDeadlineExceeded(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r := &testResource{
+		deadlines: map[string]time.Duration{},
+		wait:      true,
+	}
+
+	wrapped := timeouts.WrapResource(r, timeouts.Defaults{})
+
+	plan := tfsdk.Plan{
+		Raw:    testWrapperRaw(tftypes.NewValue(tftypes.String, "1ns")),
+		Schema: testWrapperSchema(ctx),
+	}
+
+	resp := &resource.CreateResponse{}
+
+	wrapped.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	if got, expected := resp.Diagnostics.ErrorsCount(), 2; got != expected {
+		t.Fatalf("expected %d error diagnostics, got %d: %v", expected, got, resp.Diagnostics)
+	}
+
+	got, ok := resp.Diagnostics[1].(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("expected diagnostic with path, got %T", resp.Diagnostics[1])
+	}
+
+	if diff := cmp.Diff(got.Summary(), "Operation Timed Out"); diff != "" {
+		t.Errorf("unexpected summary difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.Path(), path.Root("timeouts").AtName("create")); diff != "" {
+		t.Errorf("unexpected path difference: %s", diff)
+	}
+
+	// The snippet uses the block syntax of the schema.
+	if expected := "for example:\n\n  timeouts {\n    create = \"2ns\"\n  }"; !strings.HasSuffix(got.Detail(), expected) {
+		t.Errorf("expected detail ending with %q, got %q", expected, got.Detail())
+	}
+}
+
+func TestWrapResourceImportState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r := &testResourceWithImportState{
+		testResource: testResource{
+			deadlines: map[string]time.Duration{},
+		},
+	}
+
+	wrapped, ok := timeouts.WrapResource(r, timeouts.Defaults{
+		Import: 10 * time.Minute,
+	}).(resource.ResourceWithImportState)
+	if !ok {
+		t.Fatal("expected wrapped resource to implement resource.ResourceWithImportState")
+	}
+
+	resp := &resource.ImportStateResponse{}
+
+	wrapped.ImportState(ctx, resource.ImportStateRequest{ID: "example"}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(r.deadlines, map[string]time.Duration{"import": 10 * time.Minute}); diff != "" {
+		t.Errorf("unexpected deadlines difference: %s", diff)
+	}
+}
+
+// The capability types of testResource, in the order of the optional interfaces
+// in TestWrapResourceOptionalInterfaces.
+//
+//go:generate go run ../../internal/cmd/gencombinations -output wrapper_combinations_test.go -package timeouts_test -function combineTestResource -base testResource -result resource.Resource -import github.com/hashicorp/terraform-plugin-framework/resource testModifyPlan testImportState testMoveState testUpgradeState testIdentity
+type testModifyPlan struct {
+	*testResource
+}
+
+func (testModifyPlan) ModifyPlan(_ context.Context, _ resource.ModifyPlanRequest, _ *resource.ModifyPlanResponse) {
+}
+
+type testImportState struct {
+	*testResource
+}
+
+func (testImportState) ImportState(_ context.Context, _ resource.ImportStateRequest, _ *resource.ImportStateResponse) {
+}
+
+type testMoveState struct {
+	*testResource
+}
+
+func (testMoveState) MoveState(_ context.Context) []resource.StateMover {
+	return nil
+}
+
+type testUpgradeState struct {
+	*testResource
+}
+
+func (testUpgradeState) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return nil
+}
+
+type testIdentity struct {
+	*testResource
+}
+
+func (testIdentity) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, _ *resource.IdentitySchemaResponse) {
+}
+
+func TestWrapResourceOptionalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	interfaces := []struct {
+		name        string
+		implemented func(resource.Resource) bool
+	}{
+		{
+			name: "ModifyPlan",
+			implemented: func(r resource.Resource) bool {
+				_, ok := r.(resource.ResourceWithModifyPlan)
+
+				return ok
+			},
+		},
+		{
+			name: "ImportState",
+			implemented: func(r resource.Resource) bool {
+				_, ok := r.(resource.ResourceWithImportState)
+
+				return ok
+			},
+		},
+		{
+			name: "MoveState",
+			implemented: func(r resource.Resource) bool {
+				_, ok := r.(resource.ResourceWithMoveState)
+
+				return ok
+			},
+		},
+		{
+			name: "UpgradeState",
+			implemented: func(r resource.Resource) bool {
+				_, ok := r.(resource.ResourceWithUpgradeState)
+
+				return ok
+			},
+		},
+		{
+			name: "Identity",
+			implemented: func(r resource.Resource) bool {
+				_, ok := r.(resource.ResourceWithIdentity)
+
+				return ok
+			},
+		},
+	}
+
+	for wraps := 0; wraps < 1<<len(interfaces); wraps++ {
+		r := combineTestResource(&testResource{}, wraps)
+		wrapped := timeouts.WrapResource(r, timeouts.Defaults{})
+
+		for i, iface := range interfaces {
+			if expected := wraps&(1<<i) != 0; iface.implemented(r) != expected {
+				t.Fatalf("combination %05b: expected test resource %s to be %t", wraps, iface.name, expected)
+			}
+
+			if got, expected := iface.implemented(wrapped), iface.implemented(r); got != expected {
+				t.Errorf("combination %05b: expected wrapped resource %s to be %t, got %t", wraps, iface.name, expected, got)
+			}
+		}
+	}
+}
+
+func TestWrapResourceNilSchema(t *testing.T) {
+	t.Parallel()
+
+	r := &testResource{
+		deadlines: map[string]time.Duration{},
+	}
+
+	wrapped := timeouts.WrapResource(r, timeouts.Defaults{
+		Create: 10 * time.Minute,
+	})
+
+	resp := &resource.CreateResponse{}
+
+	wrapped.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{
+			Raw: testWrapperRaw(tftypes.NewValue(tftypes.String, "5m")),
+		},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(r.deadlines, map[string]time.Duration{"create": 10 * time.Minute}); diff != "" {
+		t.Errorf("unexpected deadlines difference: %s", diff)
+	}
+}
+
+func TestWrapResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := timeouts.WrapResource(&testResource{}, timeouts.Defaults{}).(resource.ResourceWithIdentity); ok {
+		t.Error("expected wrapped resource without identity not to implement resource.ResourceWithIdentity")
+	}
+
+	wrapped, ok := timeouts.WrapResource(&testResourceWithIdentity{}, timeouts.Defaults{}).(resource.ResourceWithIdentity)
+	if !ok {
+		t.Fatal("expected wrapped resource to implement resource.ResourceWithIdentity")
+	}
+
+	resp := &resource.IdentitySchemaResponse{}
+
+	wrapped.IdentitySchema(context.Background(), resource.IdentitySchemaRequest{}, resp)
+
+	if _, ok := resp.IdentitySchema.Attributes["id"]; !ok {
+		t.Error("expected identity schema to be delegated to the wrapped resource")
+	}
+}
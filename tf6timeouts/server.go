@@ -0,0 +1,928 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tf6timeouts applies timeouts to protocol version 6 provider servers
+// which are not implemented with the framework, such as hand-written resources
+// combined with framework resources in a muxed provider.
+package tf6timeouts
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/duration"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/explain"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/resolver"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/tftimeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutctx"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/internal/timeoutdiag"
+)
+
+const (
+	operationNameConfigure = "configure"
+	operationNameCreate    = "create"
+	operationNameDelete    = "delete"
+	operationNameImport    = "import"
+	operationNameInvoke    = "invoke"
+	operationNameList      = "list"
+	operationNameOpen      = "open"
+	operationNamePlan      = "plan"
+	operationNameRead      = "read"
+	operationNameUpdate    = "update"
+
+	attributeNameDefault = "default"
+
+	providerMetaAttributeNameDefaultTimeouts  = "default_timeouts"
+	providerMetaAttributeNameResourceTimeouts = "resource_timeouts"
+)
+
+//nolint:staticcheck // The list resource, action and state store RPCs are only available via the temporary interfaces.
+var (
+	_ tfprotov6.ProviderServer = &server{}
+
+	_ tfprotov6.ProviderServerWithListResource = struct {
+		*server
+		listResourceServer
+	}{}
+	_ tfprotov6.ProviderServerWithActions = struct {
+		*server
+		actionServer
+	}{}
+	_ tfprotov6.ProviderServerWithStateStores = struct {
+		*server
+		stateStoreServer
+	}{}
+)
+
+// The cores resolve the timeouts of each type following the rules of the matching
+// framework package.
+var (
+	providerCore = tftimeouts.Core{
+		Operations: []string{operationNameConfigure},
+	}
+
+	resourceCore = tftimeouts.Core{
+		Operations:        []string{operationNameCreate, operationNameRead, operationNameUpdate, operationNameDelete, operationNamePlan},
+		ProviderDefault:   moduleDefault,
+		DefaultAttribute:  attributeNameDefault,
+		DefaultOperations: []string{operationNameCreate, operationNameRead, operationNameUpdate, operationNameDelete},
+		AllowNone:         true,
+	}
+
+	dataSourceCore = tftimeouts.Core{
+		Operations: []string{operationNameRead},
+	}
+
+	ephemeralResourceCore = tftimeouts.Core{
+		Operations: []string{operationNameOpen},
+	}
+
+	listResourceCore = tftimeouts.Core{
+		Operations: []string{operationNameList},
+	}
+
+	actionCore = tftimeouts.Core{
+		Operations: []string{operationNameInvoke},
+	}
+)
+
+// The optional RPCs which are only implemented by the middleware when
+// implemented by the wrapped server. NewProviderServer composes server with the
+// matching capability types via the generated combineServer, so the bits must be
+// in the order of the capability types listed below.
+//
+//go:generate go run ../internal/cmd/gencombinations -output server_combinations.go -package tf6timeouts -function combineServer -base server -result tfprotov6.ProviderServer -import github.com/hashicorp/terraform-plugin-go/tfprotov6 listResourceServer actionServer stateStoreServer
+const (
+	wrapsListResource = 1 << iota
+	wrapsActions
+	wrapsStateStores
+)
+
+// moduleDefaultsContextKey is the context key under which the Defaults read from
+// provider_meta are stored.
+type moduleDefaultsContextKey struct{}
+
+// Defaults contains the default timeout for each operation of a resource, data
+// source, ephemeral resource, list resource, action or provider, keyed by the
+// name of the timeout attribute for the operation, such as "create". Operations
+// without a default have no timeout unless one is configured.
+type Defaults map[string]time.Duration
+
+// Opts contains the default timeouts for each type supported by the provider
+// server, keyed by type name, such as "examplecloud_thing".
+type Opts struct {
+	// Provider contains the default timeout for the "configure" operation.
+	Provider Defaults
+
+	// Resources contains the default timeouts for the "create", "read",
+	// "update", "delete", "plan" and "import" operations of each resource type.
+	Resources map[string]Defaults
+
+	// DataSources contains the default timeout for the "read" operation of each
+	// data source type.
+	DataSources map[string]Defaults
+
+	// EphemeralResources contains the default timeout for the "open" operation
+	// of each ephemeral resource type.
+	EphemeralResources map[string]Defaults
+
+	// ListResources contains the default timeout for the "list" operation of
+	// each list resource type.
+	ListResources map[string]Defaults
+
+	// Actions contains the default timeout for the "invoke" operation of each
+	// action type.
+	Actions map[string]Defaults
+}
+
+// NewProviderServer returns a tfprotov6.ProviderServer which applies timeouts to
+// the supplied server. The timeouts attribute or block at the root of the schema
+// of each type is read from the raw configuration, plan or state of the request,
+// following the same rules as the Value accessors of the framework packages, and
+// the context passed to the supplied server is cancelled when the timeout for the
+// operation, or the "deadline" attribute, is reached:
+//
+//   - ConfigureProvider: "configure", from the configuration.
+//   - ReadResource: "read", from the current state.
+//   - PlanResourceChange: "plan", from the proposed new state, or the prior
+//     state when the resource is being destroyed.
+//   - ApplyResourceChange: "create", "update" or "delete", from the planned
+//     state, or the prior state when the resource is being deleted.
+//   - ImportResourceState: "import", from the defaults only.
+//   - ReadDataSource: "read", from the configuration.
+//   - OpenEphemeralResource: "open", from the configuration.
+//   - ListResource: "list", from the configuration.
+//   - InvokeAction: "invoke", from the configuration.
+//
+// For ListResource and InvokeAction, the context is cancelled once the returned
+// stream has been consumed. If a timeout cannot be resolved, the request is not
+// passed to the supplied server and the error diagnostic is returned. If the
+// context times out and the supplied server returns error diagnostics, the
+// standard timeout diagnostic is also returned.
+//
+// The timeouts of resources follow the rules of resource/timeouts: the "default"
+// attribute of a block generated for SDKv2 compatibility applies to operations
+// which have not been configured, "none" disables the timeout, and the defaults
+// are replaced by any defaults set by the module in the provider_meta attributes
+// returned by the provider/timeouts MetaAttributes function.
+//
+// Schemas are read from the supplied server with GetProviderSchema when first
+// required, and cached once they have been read without errors. The list
+// resource, action and state store RPCs are only implemented by the returned
+// server if they are implemented by the supplied server. All other requests are
+// passed to the supplied server as-is.
+func NewProviderServer(providerServer tfprotov6.ProviderServer, opts Opts) tfprotov6.ProviderServer {
+	s := &server{
+		ProviderServer: providerServer,
+		opts:           opts,
+	}
+
+	var wraps int
+
+	//nolint:staticcheck // ListResource RPCs are only available via the temporary interface.
+	if _, ok := providerServer.(tfprotov6.ProviderServerWithListResource); ok {
+		wraps |= wrapsListResource
+	}
+
+	//nolint:staticcheck // Action RPCs are only available via the temporary interface.
+	if _, ok := providerServer.(tfprotov6.ProviderServerWithActions); ok {
+		wraps |= wrapsActions
+	}
+
+	//nolint:staticcheck // The state store RPCs are only available via the temporary interface.
+	if _, ok := providerServer.(tfprotov6.ProviderServerWithStateStores); ok {
+		wraps |= wrapsStateStores
+	}
+
+	return combineServer(s, wraps)
+}
+
+// server applies timeouts to the requests of a tfprotov6.ProviderServer.
+type server struct {
+	tfprotov6.ProviderServer
+
+	opts Opts
+
+	schemasMutex sync.Mutex
+	schemas      *tfprotov6.GetProviderSchemaResponse
+}
+
+// ConfigureProvider calls the wrapped ConfigureProvider with a context limited by
+// the "configure" timeout.
+func (s *server) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	ctx, cancel, diags := s.context(ctx, providerCore, s.providerSchema, "", req.Config, operationNameConfigure, s.opts.Provider)
+	defer cancel()
+
+	if hasError(diags) {
+		return &tfprotov6.ConfigureProviderResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = s.appendDeadlineExceeded(ctx, s.providerSchema, "", resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ReadResource calls the wrapped ReadResource with a context limited by the
+// "read" timeout.
+func (s *server) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx, diags := s.contextWithProviderMeta(ctx, req.TypeName, req.ProviderMeta)
+	if hasError(diags) {
+		return &tfprotov6.ReadResourceResponse{Diagnostics: diags, NewState: req.CurrentState}, nil
+	}
+
+	ctx, cancel, d := s.context(ctx, resourceCore, s.resourceSchema, req.TypeName, req.CurrentState, operationNameRead, s.opts.Resources[req.TypeName])
+	defer cancel()
+
+	diags = append(diags, d...)
+
+	if hasError(diags) {
+		return &tfprotov6.ReadResourceResponse{Diagnostics: diags, NewState: req.CurrentState}, nil
+	}
+
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = s.appendDeadlineExceeded(ctx, s.resourceSchema, req.TypeName, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// PlanResourceChange calls the wrapped PlanResourceChange with a context limited
+// by the "plan" timeout.
+func (s *server) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx, diags := s.contextWithProviderMeta(ctx, req.TypeName, req.ProviderMeta)
+	if hasError(diags) {
+		return &tfprotov6.PlanResourceChangeResponse{Diagnostics: diags, PlannedState: req.ProposedNewState}, nil
+	}
+
+	value := req.ProposedNewState
+
+	if s.isNull(ctx, s.resourceSchema, req.TypeName, value) {
+		value = req.PriorState
+	}
+
+	ctx, cancel, d := s.context(ctx, resourceCore, s.resourceSchema, req.TypeName, value, operationNamePlan, s.opts.Resources[req.TypeName])
+	defer cancel()
+
+	diags = append(diags, d...)
+
+	if hasError(diags) {
+		return &tfprotov6.PlanResourceChangeResponse{Diagnostics: diags, PlannedState: req.ProposedNewState}, nil
+	}
+
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = s.appendDeadlineExceeded(ctx, s.resourceSchema, req.TypeName, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ApplyResourceChange calls the wrapped ApplyResourceChange with a context limited
+// by the "create", "update" or "delete" timeout.
+func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	ctx, diags := s.contextWithProviderMeta(ctx, req.TypeName, req.ProviderMeta)
+	if hasError(diags) {
+		return &tfprotov6.ApplyResourceChangeResponse{Diagnostics: diags, NewState: req.PriorState}, nil
+	}
+
+	value, operationName := req.PlannedState, operationNameUpdate
+
+	switch {
+	case s.isNull(ctx, s.resourceSchema, req.TypeName, req.PlannedState):
+		value, operationName = req.PriorState, operationNameDelete
+	case s.isNull(ctx, s.resourceSchema, req.TypeName, req.PriorState):
+		operationName = operationNameCreate
+	}
+
+	ctx, cancel, d := s.context(ctx, resourceCore, s.resourceSchema, req.TypeName, value, operationName, s.opts.Resources[req.TypeName])
+	defer cancel()
+
+	diags = append(diags, d...)
+
+	if hasError(diags) {
+		return &tfprotov6.ApplyResourceChangeResponse{Diagnostics: diags, NewState: req.PriorState}, nil
+	}
+
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = s.appendDeadlineExceeded(ctx, s.resourceSchema, req.TypeName, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ImportResourceState calls the wrapped ImportResourceState with a context limited
+// by the "import" timeout.
+func (s *server) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx, cancel, diags := s.context(ctx, resourceCore, nil, req.TypeName, nil, operationNameImport, s.opts.Resources[req.TypeName])
+	defer cancel()
+
+	if hasError(diags) {
+		return &tfprotov6.ImportResourceStateResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = s.appendDeadlineExceeded(ctx, nil, req.TypeName, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// ReadDataSource calls the wrapped ReadDataSource with a context limited by the
+// "read" timeout.
+func (s *server) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx, cancel, diags := s.context(ctx, dataSourceCore, s.dataSourceSchema, req.TypeName, req.Config, operationNameRead, s.opts.DataSources[req.TypeName])
+	defer cancel()
+
+	if hasError(diags) {
+		return &tfprotov6.ReadDataSourceResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = s.appendDeadlineExceeded(ctx, s.dataSourceSchema, req.TypeName, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// OpenEphemeralResource calls the wrapped OpenEphemeralResource with a context
+// limited by the "open" timeout.
+func (s *server) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	ctx, cancel, diags := s.context(ctx, ephemeralResourceCore, s.ephemeralResourceSchema, req.TypeName, req.Config, operationNameOpen, s.opts.EphemeralResources[req.TypeName])
+	defer cancel()
+
+	if hasError(diags) {
+		return &tfprotov6.OpenEphemeralResourceResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.OpenEphemeralResource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = s.appendDeadlineExceeded(ctx, s.ephemeralResourceSchema, req.TypeName, resp.Diagnostics)
+	}
+
+	return resp, err
+}
+
+// listResourceServer implements the list resource RPCs for wrapped servers which
+// implement them.
+type listResourceServer struct {
+	s *server
+}
+
+//nolint:staticcheck // ListResource RPCs are only available via the temporary interface.
+func (l listResourceServer) wrapped() tfprotov6.ProviderServerWithListResource {
+	//nolint:forcetypeassert // NewProviderServer only embeds this type for servers with list resources.
+	return l.s.ProviderServer.(tfprotov6.ProviderServerWithListResource)
+}
+
+// ValidateListResourceConfig calls the wrapped ValidateListResourceConfig.
+func (l listResourceServer) ValidateListResourceConfig(ctx context.Context, req *tfprotov6.ValidateListResourceConfigRequest) (*tfprotov6.ValidateListResourceConfigResponse, error) {
+	return l.wrapped().ValidateListResourceConfig(ctx, req)
+}
+
+// ListResource calls the wrapped ListResource with a context limited by the
+// "list" timeout, which is cancelled once the results have been consumed.
+func (l listResourceServer) ListResource(ctx context.Context, req *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	ctx, cancel, diags := l.s.context(ctx, listResourceCore, l.s.listResourceSchema, req.TypeName, req.Config, operationNameList, l.s.opts.ListResources[req.TypeName])
+
+	if hasError(diags) {
+		cancel()
+
+		return listDiagnostics(diags), nil
+	}
+
+	stream, err := l.wrapped().ListResource(ctx, req)
+	if stream == nil {
+		cancel()
+
+		return stream, err
+	}
+
+	results := stream.Results
+
+	stream.Results = func(yield func(tfprotov6.ListResourceResult) bool) {
+		defer cancel()
+
+		if results == nil {
+			return
+		}
+
+		for result := range results {
+			if len(result.Diagnostics) > 0 {
+				result.Diagnostics = l.s.appendDeadlineExceeded(ctx, l.s.listResourceSchema, req.TypeName, result.Diagnostics)
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+
+	return stream, err
+}
+
+// actionServer implements the action RPCs for wrapped servers which implement
+// them.
+type actionServer struct {
+	s *server
+}
+
+//nolint:staticcheck // Action RPCs are only available via the temporary interface.
+func (a actionServer) wrapped() tfprotov6.ProviderServerWithActions {
+	//nolint:forcetypeassert // NewProviderServer only embeds this type for servers with actions.
+	return a.s.ProviderServer.(tfprotov6.ProviderServerWithActions)
+}
+
+// ValidateActionConfig calls the wrapped ValidateActionConfig.
+func (a actionServer) ValidateActionConfig(ctx context.Context, req *tfprotov6.ValidateActionConfigRequest) (*tfprotov6.ValidateActionConfigResponse, error) {
+	return a.wrapped().ValidateActionConfig(ctx, req)
+}
+
+// PlanAction calls the wrapped PlanAction.
+func (a actionServer) PlanAction(ctx context.Context, req *tfprotov6.PlanActionRequest) (*tfprotov6.PlanActionResponse, error) {
+	return a.wrapped().PlanAction(ctx, req)
+}
+
+// InvokeAction calls the wrapped InvokeAction with a context limited by the
+// "invoke" timeout, which is cancelled once the events have been consumed.
+func (a actionServer) InvokeAction(ctx context.Context, req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionServerStream, error) {
+	ctx, cancel, diags := a.s.context(ctx, actionCore, a.s.actionSchema, req.ActionType, req.Config, operationNameInvoke, a.s.opts.Actions[req.ActionType])
+
+	if hasError(diags) {
+		cancel()
+
+		return actionDiagnostics(diags), nil
+	}
+
+	stream, err := a.wrapped().InvokeAction(ctx, req)
+	if stream == nil {
+		cancel()
+
+		return stream, err
+	}
+
+	events := stream.Events
+
+	stream.Events = func(yield func(tfprotov6.InvokeActionEvent) bool) {
+		defer cancel()
+
+		if events == nil {
+			return
+		}
+
+		for event := range events {
+			if completed, ok := event.Type.(tfprotov6.CompletedInvokeActionEventType); ok {
+				completed.Diagnostics = a.s.appendDeadlineExceeded(ctx, a.s.actionSchema, req.ActionType, completed.Diagnostics)
+				event.Type = completed
+			}
+
+			if !yield(event) {
+				return
+			}
+		}
+	}
+
+	return stream, err
+}
+
+// context returns a copy of ctx limited by the named timeout, resolved by core
+// from the timeouts of the supplied value if the schema returned by schemaFunc for
+// the type defines them. A nil schemaFunc is used for operations which cannot be
+// configured, such as "import", whose timeout is resolved from the defaults only.
+func (s *server) context(ctx context.Context, core tftimeouts.Core, schemaFunc func(context.Context, string) (*tfprotov6.Schema, []*tfprotov6.Diagnostic), typeName string, dynamicValue *tfprotov6.DynamicValue, timeoutName string, defaults Defaults) (context.Context, context.CancelFunc, []*tfprotov6.Diagnostic) {
+	var diags []*tfprotov6.Diagnostic
+
+	timeouts := tftypes.NewValue(tftypes.Object{}, nil)
+
+	if schemaFunc != nil && dynamicValue != nil {
+		schema, d := schemaFunc(ctx, typeName)
+		diags = append(diags, d...)
+
+		if schema != nil {
+			value, err := dynamicValue.Unmarshal(schema.ValueType())
+			if err != nil {
+				diags = append(diags, &tfprotov6.Diagnostic{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Timeouts Cannot Be Read",
+					Detail:   fmt.Sprintf("timeouts cannot be read from %q, %s", typeName, err),
+				})
+			} else {
+				timeouts = tftimeouts.FromObject(value)
+			}
+		}
+	}
+
+	defaultTimeout, ok := defaults[timeoutName]
+	if !ok || defaultTimeout == 0 {
+		defaultTimeout = duration.None
+	}
+
+	var r resolver.Result
+	var err error
+
+	if schemaFunc == nil {
+		r, err = tftimeouts.ResolveUnconfigured(ctx, core, timeoutName, defaultTimeout)
+	} else {
+		r, err = tftimeouts.Resolve(ctx, core, timeouts, timeoutName, defaultTimeout)
+	}
+
+	timeout := r.Timeout
+
+	if err != nil {
+		diags = append(diags, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Timeout Cannot Be Parsed",
+			Detail:   err.Error(),
+		})
+	}
+
+	deadline, ok, err := tftimeouts.Deadline(timeouts)
+	if err != nil {
+		diags = append(diags, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Deadline Cannot Be Parsed",
+			Detail:   err.Error(),
+		})
+	}
+
+	if ok && (timeout == duration.None || deadline.Before(time.Now().Add(timeout))) {
+		ctx, cancel := timeoutctx.WithDeadline(ctx, timeoutName, deadline, timeout)
+
+		return ctx, cancel, diags
+	}
+
+	ctx, cancel := timeoutctx.WithTimeout(ctx, timeoutName, timeout, r.Source)
+
+	return ctx, cancel, diags
+}
+
+// isNull returns whether the supplied value is missing or null.
+func (s *server) isNull(ctx context.Context, schemaFunc func(context.Context, string) (*tfprotov6.Schema, []*tfprotov6.Diagnostic), typeName string, dynamicValue *tfprotov6.DynamicValue) bool {
+	if dynamicValue == nil {
+		return true
+	}
+
+	schema, _ := schemaFunc(ctx, typeName)
+	if schema == nil {
+		return false
+	}
+
+	value, err := dynamicValue.Unmarshal(schema.ValueType())
+	if err != nil {
+		return false
+	}
+
+	return value.IsNull()
+}
+
+// contextWithProviderMeta returns a copy of ctx containing the default timeouts
+// for the named resource type set by the module in the supplied provider_meta,
+// for use by moduleDefault. Timeouts set for the resource type within
+// "resource_timeouts" take precedence over those set within "default_timeouts".
+// If the provider meta schema does not contain the attributes, or the module does
+// not contain a provider_meta block, ctx is returned unchanged.
+func (s *server) contextWithProviderMeta(ctx context.Context, typeName string, providerMeta *tfprotov6.DynamicValue) (context.Context, []*tfprotov6.Diagnostic) {
+	if providerMeta == nil {
+		return ctx, nil
+	}
+
+	schemas := s.providerSchemas(ctx)
+	if schemas.ProviderMeta == nil {
+		return ctx, schemas.Diagnostics
+	}
+
+	value, err := providerMeta.Unmarshal(schemas.ProviderMeta.ValueType())
+	if err != nil {
+		return ctx, append(schemas.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Provider Meta Timeouts Cannot Be Read",
+			Detail:   fmt.Sprintf("provider_meta cannot be read, %s", err),
+		})
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if !value.IsKnown() || value.IsNull() || value.As(&attributes) != nil {
+		return ctx, schemas.Diagnostics
+	}
+
+	diags := schemas.Diagnostics
+	defaults := Defaults{}
+
+	var resourceTimeouts map[string]tftypes.Value
+
+	if value, ok := attributes[providerMetaAttributeNameResourceTimeouts]; ok && value.IsKnown() && !value.IsNull() && value.As(&resourceTimeouts) == nil {
+		if value, ok := resourceTimeouts[typeName]; ok {
+			diags = append(diags, defaultsFromObject(value, defaults)...)
+		}
+	}
+
+	if value, ok := attributes[providerMetaAttributeNameDefaultTimeouts]; ok {
+		diags = append(diags, defaultsFromObject(value, defaults)...)
+	}
+
+	return context.WithValue(ctx, moduleDefaultsContextKey{}, defaults), diags
+}
+
+// defaultsFromObject parses each of the string attributes of the supplied object
+// as time.Duration and adds them to defaults, unless already set. Attributes which
+// are null, unknown, cannot be parsed or are not greater than zero are left unset.
+func defaultsFromObject(object tftypes.Value, defaults Defaults) []*tfprotov6.Diagnostic {
+	var diags []*tfprotov6.Diagnostic
+
+	var attributes map[string]tftypes.Value
+
+	if !object.IsKnown() || object.IsNull() || object.As(&attributes) != nil {
+		return diags
+	}
+
+	for name, value := range attributes {
+		var s string
+
+		if _, ok := defaults[name]; ok || !value.IsKnown() || value.IsNull() || value.As(&s) != nil {
+			continue
+		}
+
+		timeout, err := time.ParseDuration(s)
+		if err != nil {
+			diags = append(diags, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Timeout Cannot Be Parsed",
+				Detail:   fmt.Sprintf("default timeout for %q cannot be parsed, %s", name, err),
+			})
+
+			continue
+		}
+
+		if timeout <= 0 {
+			diags = append(diags, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Timeout Cannot Be Parsed",
+				Detail:   fmt.Sprintf("default timeout for %q must be greater than zero, got %s", name, s),
+			})
+
+			continue
+		}
+
+		defaults[name] = timeout
+	}
+
+	return diags
+}
+
+// moduleDefault returns the default for the named operation from any module
+// defaults stored in ctx by contextWithProviderMeta, otherwise the supplied
+// default timeout, along with the Source of the returned default.
+func moduleDefault(ctx context.Context, name string, defaultTimeout time.Duration) (time.Duration, timeoutctx.Source) {
+	defaults, _ := ctx.Value(moduleDefaultsContextKey{}).(Defaults)
+
+	if timeout, ok := defaults[name]; ok && timeout != 0 {
+		logging.Trace(ctx, name+" timeout provider_meta default found, using in place of provided default", map[string]interface{}{
+			logging.KeyOperation: name,
+			logging.KeyTimeout:   timeout.String(),
+		})
+		explain.Record(ctx, "provider_meta default %s replaces default %s", duration.String(timeout), duration.String(defaultTimeout))
+
+		return timeout, timeoutctx.SourceModuleDefault
+	}
+
+	explain.Record(ctx, "default %s", duration.String(defaultTimeout))
+
+	return defaultTimeout, timeoutctx.SourceDefault
+}
+
+// providerSchemas returns the schemas of the wrapped server. They are read with a
+// context which is not cancelled with ctx, as the request may be about to time
+// out, and only cached once they have been read without errors, so that failures
+// are retried by later requests.
+func (s *server) providerSchemas(ctx context.Context) *tfprotov6.GetProviderSchemaResponse {
+	s.schemasMutex.Lock()
+	defer s.schemasMutex.Unlock()
+
+	if s.schemas != nil {
+		return s.schemas
+	}
+
+	resp, err := s.ProviderServer.GetProviderSchema(context.WithoutCancel(ctx), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil || resp == nil {
+		return &tfprotov6.GetProviderSchemaResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Timeouts Cannot Be Read",
+					Detail:   fmt.Sprintf("schemas cannot be read from the provider server, %v", err),
+				},
+			},
+		}
+	}
+
+	if !hasError(resp.Diagnostics) {
+		s.schemas = resp
+	}
+
+	return resp
+}
+
+func (s *server) providerSchema(ctx context.Context, _ string) (*tfprotov6.Schema, []*tfprotov6.Diagnostic) {
+	schemas := s.providerSchemas(ctx)
+
+	return schemas.Provider, schemas.Diagnostics
+}
+
+func (s *server) resourceSchema(ctx context.Context, typeName string) (*tfprotov6.Schema, []*tfprotov6.Diagnostic) {
+	schemas := s.providerSchemas(ctx)
+
+	return schemas.ResourceSchemas[typeName], schemas.Diagnostics
+}
+
+func (s *server) dataSourceSchema(ctx context.Context, typeName string) (*tfprotov6.Schema, []*tfprotov6.Diagnostic) {
+	schemas := s.providerSchemas(ctx)
+
+	return schemas.DataSourceSchemas[typeName], schemas.Diagnostics
+}
+
+func (s *server) ephemeralResourceSchema(ctx context.Context, typeName string) (*tfprotov6.Schema, []*tfprotov6.Diagnostic) {
+	schemas := s.providerSchemas(ctx)
+
+	return schemas.EphemeralResourceSchemas[typeName], schemas.Diagnostics
+}
+
+func (s *server) listResourceSchema(ctx context.Context, typeName string) (*tfprotov6.Schema, []*tfprotov6.Diagnostic) {
+	schemas := s.providerSchemas(ctx)
+
+	return schemas.ListResourceSchemas[typeName], schemas.Diagnostics
+}
+
+func (s *server) actionSchema(ctx context.Context, typeName string) (*tfprotov6.Schema, []*tfprotov6.Diagnostic) {
+	schemas := s.providerSchemas(ctx)
+
+	actionSchema, ok := schemas.ActionSchemas[typeName]
+	if !ok || actionSchema == nil {
+		return nil, schemas.Diagnostics
+	}
+
+	return actionSchema.Schema, schemas.Diagnostics
+}
+
+// stateStoreServer implements the state store RPCs for wrapped servers which
+// implement them.
+type stateStoreServer struct {
+	s *server
+}
+
+//nolint:staticcheck // The state store RPCs are only available via the temporary interface.
+func (s stateStoreServer) wrapped() tfprotov6.ProviderServerWithStateStores {
+	//nolint:forcetypeassert // NewProviderServer only embeds this type for servers with state stores.
+	return s.s.ProviderServer.(tfprotov6.ProviderServerWithStateStores)
+}
+
+// ValidateStateStoreConfig calls the wrapped ValidateStateStoreConfig.
+func (s stateStoreServer) ValidateStateStoreConfig(ctx context.Context, req *tfprotov6.ValidateStateStoreConfigRequest) (*tfprotov6.ValidateStateStoreConfigResponse, error) {
+	return s.wrapped().ValidateStateStoreConfig(ctx, req)
+}
+
+// ConfigureStateStore calls the wrapped ConfigureStateStore.
+func (s stateStoreServer) ConfigureStateStore(ctx context.Context, req *tfprotov6.ConfigureStateStoreRequest) (*tfprotov6.ConfigureStateStoreResponse, error) {
+	return s.wrapped().ConfigureStateStore(ctx, req)
+}
+
+// ReadStateBytes calls the wrapped ReadStateBytes.
+func (s stateStoreServer) ReadStateBytes(ctx context.Context, req *tfprotov6.ReadStateBytesRequest) (*tfprotov6.ReadStateBytesStream, error) {
+	return s.wrapped().ReadStateBytes(ctx, req)
+}
+
+// WriteStateBytes calls the wrapped WriteStateBytes.
+func (s stateStoreServer) WriteStateBytes(ctx context.Context, stream *tfprotov6.WriteStateBytesStream) (*tfprotov6.WriteStateBytesResponse, error) {
+	return s.wrapped().WriteStateBytes(ctx, stream)
+}
+
+// GetStates calls the wrapped GetStates.
+func (s stateStoreServer) GetStates(ctx context.Context, req *tfprotov6.GetStatesRequest) (*tfprotov6.GetStatesResponse, error) {
+	return s.wrapped().GetStates(ctx, req)
+}
+
+// DeleteState calls the wrapped DeleteState.
+func (s stateStoreServer) DeleteState(ctx context.Context, req *tfprotov6.DeleteStateRequest) (*tfprotov6.DeleteStateResponse, error) {
+	return s.wrapped().DeleteState(ctx, req)
+}
+
+// LockState calls the wrapped LockState.
+func (s stateStoreServer) LockState(ctx context.Context, req *tfprotov6.LockStateRequest) (*tfprotov6.LockStateResponse, error) {
+	return s.wrapped().LockState(ctx, req)
+}
+
+// UnlockState calls the wrapped UnlockState.
+func (s stateStoreServer) UnlockState(ctx context.Context, req *tfprotov6.UnlockStateRequest) (*tfprotov6.UnlockStateResponse, error) {
+	return s.wrapped().UnlockState(ctx, req)
+}
+
+// appendDeadlineExceeded returns diags with the standard timeout diagnostic added
+// if ctx has timed out and diags contains an error, which was then most likely
+// caused by the timeout. The snippet in the diagnostic uses the syntax of the
+// timeouts in the schema returned by schemaFunc for the type.
+func (s *server) appendDeadlineExceeded(ctx context.Context, schemaFunc func(context.Context, string) (*tfprotov6.Schema, []*tfprotov6.Diagnostic), typeName string, diags []*tfprotov6.Diagnostic) []*tfprotov6.Diagnostic {
+	if !hasError(diags) {
+		return diags
+	}
+
+	timeoutErr, ok := timeoutctx.FromError(ctx, ctx.Err())
+	if !ok {
+		return diags
+	}
+
+	syntax := timeoutdiag.SyntaxUnknown
+
+	if schemaFunc != nil {
+		schema, _ := schemaFunc(ctx, typeName)
+		syntax = timeoutsSyntax(schema)
+	}
+
+	d := timeoutdiag.Diagnostic(timeoutErr, path.Root(tftimeouts.AttributeName), syntax)
+
+	diagnostic := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  d.Summary(),
+		Detail:   d.Detail(),
+	}
+
+	if withPath, ok := d.(interface{ Path() path.Path }); ok {
+		attributePath := tftypes.NewAttributePath()
+
+		for _, step := range withPath.Path().Steps() {
+			if name, ok := step.(path.PathStepAttributeName); ok {
+				attributePath = attributePath.WithAttributeName(string(name))
+			}
+		}
+
+		diagnostic.Attribute = attributePath
+	}
+
+	return append(diags, diagnostic)
+}
+
+// timeoutsSyntax returns whether the timeouts of schema are a block or an
+// attribute.
+func timeoutsSyntax(schema *tfprotov6.Schema) timeoutdiag.Syntax {
+	if schema == nil || schema.Block == nil {
+		return timeoutdiag.SyntaxUnknown
+	}
+
+	for _, block := range schema.Block.BlockTypes {
+		if block.TypeName == tftimeouts.AttributeName {
+			return timeoutdiag.SyntaxBlock
+		}
+	}
+
+	for _, attribute := range schema.Block.Attributes {
+		if attribute.Name == tftimeouts.AttributeName {
+			return timeoutdiag.SyntaxAttribute
+		}
+	}
+
+	return timeoutdiag.SyntaxUnknown
+}
+
+// hasError returns whether diags contains an error diagnostic.
+func hasError(diags []*tfprotov6.Diagnostic) bool {
+	for _, d := range diags {
+		if d != nil && d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listDiagnostics returns a stream containing a single result with diags.
+func listDiagnostics(diags []*tfprotov6.Diagnostic) *tfprotov6.ListResourceServerStream {
+	return &tfprotov6.ListResourceServerStream{
+		Results: func(yield func(tfprotov6.ListResourceResult) bool) {
+			yield(tfprotov6.ListResourceResult{Diagnostics: diags})
+		},
+	}
+}
+
+// actionDiagnostics returns a stream containing a single completed event with
+// diags.
+func actionDiagnostics(diags []*tfprotov6.Diagnostic) *tfprotov6.InvokeActionServerStream {
+	return &tfprotov6.InvokeActionServerStream{
+		Events: func(yield func(tfprotov6.InvokeActionEvent) bool) {
+			yield(tfprotov6.InvokeActionEvent{
+				Type: tfprotov6.CompletedInvokeActionEventType{Diagnostics: diags},
+			})
+		},
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by gencombinations; DO NOT EDIT.
+
+package tf6timeouts
+
+import "github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+// combineServer returns base composed with the capability types
+// selected by wraps, in which bit i selects the i-th of:
+//
+//   - listResourceServer
+//   - actionServer
+//   - stateStoreServer
+func combineServer(base *server, wraps int) tfprotov6.ProviderServer {
+	switch wraps {
+	case 0b001:
+		return struct {
+			*server
+			listResourceServer
+		}{
+			base,
+			listResourceServer{base},
+		}
+	case 0b010:
+		return struct {
+			*server
+			actionServer
+		}{
+			base,
+			actionServer{base},
+		}
+	case 0b011:
+		return struct {
+			*server
+			listResourceServer
+			actionServer
+		}{
+			base,
+			listResourceServer{base},
+			actionServer{base},
+		}
+	case 0b100:
+		return struct {
+			*server
+			stateStoreServer
+		}{
+			base,
+			stateStoreServer{base},
+		}
+	case 0b101:
+		return struct {
+			*server
+			listResourceServer
+			stateStoreServer
+		}{
+			base,
+			listResourceServer{base},
+			stateStoreServer{base},
+		}
+	case 0b110:
+		return struct {
+			*server
+			actionServer
+			stateStoreServer
+		}{
+			base,
+			actionServer{base},
+			stateStoreServer{base},
+		}
+	case 0b111:
+		return struct {
+			*server
+			listResourceServer
+			actionServer
+			stateStoreServer
+		}{
+			base,
+			listResourceServer{base},
+			actionServer{base},
+			stateStoreServer{base},
+		}
+	}
+
+	return base
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by gencombinations; DO NOT EDIT.
+
+package tf6timeouts_test
+
+import "github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+// combineTestProviderServer returns base composed with the capability types
+// selected by wraps, in which bit i selects the i-th of:
+//
+//   - testListResourceRPCs
+//   - testActionRPCs
+//   - testStateStoreRPCs
+func combineTestProviderServer(base *testProviderServer, wraps int) tfprotov6.ProviderServer {
+	switch wraps {
+	case 0b001:
+		return struct {
+			*testProviderServer
+			testListResourceRPCs
+		}{
+			base,
+			testListResourceRPCs{base},
+		}
+	case 0b010:
+		return struct {
+			*testProviderServer
+			testActionRPCs
+		}{
+			base,
+			testActionRPCs{base},
+		}
+	case 0b011:
+		return struct {
+			*testProviderServer
+			testListResourceRPCs
+			testActionRPCs
+		}{
+			base,
+			testListResourceRPCs{base},
+			testActionRPCs{base},
+		}
+	case 0b100:
+		return struct {
+			*testProviderServer
+			testStateStoreRPCs
+		}{
+			base,
+			testStateStoreRPCs{base},
+		}
+	case 0b101:
+		return struct {
+			*testProviderServer
+			testListResourceRPCs
+			testStateStoreRPCs
+		}{
+			base,
+			testListResourceRPCs{base},
+			testStateStoreRPCs{base},
+		}
+	case 0b110:
+		return struct {
+			*testProviderServer
+			testActionRPCs
+			testStateStoreRPCs
+		}{
+			base,
+			testActionRPCs{base},
+			testStateStoreRPCs{base},
+		}
+	case 0b111:
+		return struct {
+			*testProviderServer
+			testListResourceRPCs
+			testActionRPCs
+			testStateStoreRPCs
+		}{
+			base,
+			testListResourceRPCs{base},
+			testActionRPCs{base},
+			testStateStoreRPCs{base},
+		}
+	}
+
+	return base
+}
@@ -0,0 +1,630 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tf6timeouts_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/tf6timeouts"
+)
+
+var (
+	testTimeoutsType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+			"delete": tftypes.String,
+		},
+	}
+
+	testResourceType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"timeouts": testTimeoutsType,
+		},
+	}
+
+	testProviderMetaType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"default_timeouts": testTimeoutsType,
+		},
+	}
+)
+
+// testProviderServer records the deadline of the context passed to each request,
+// and optionally waits for the context to be done and returns an error.
+type testProviderServer struct {
+	tfprotov6.ProviderServer
+
+	deadlines      map[string]time.Duration
+	wait           bool
+	schemaFailures int
+}
+
+func (s *testProviderServer) record(ctx context.Context, name string) []*tfprotov6.Diagnostic {
+	if deadline, ok := ctx.Deadline(); ok {
+		s.deadlines[name] = time.Until(deadline).Round(time.Minute)
+	} else {
+		s.deadlines[name] = 0
+	}
+
+	if !s.wait {
+		return nil
+	}
+
+	<-ctx.Done()
+
+	return []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Operation Failed",
+			Detail:   ctx.Err().Error(),
+		},
+	}
+}
+
+func (s *testProviderServer) GetProviderSchema(_ context.Context, _ *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	if s.schemaFailures > 0 {
+		s.schemaFailures--
+
+		return &tfprotov6.GetProviderSchemaResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Schema Unavailable",
+				},
+			},
+		}, nil
+	}
+
+	return &tfprotov6.GetProviderSchemaResponse{
+		ProviderMeta: &tfprotov6.Schema{
+			Block: &tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "default_timeouts",
+						Type:     testTimeoutsType,
+						Optional: true,
+					},
+				},
+			},
+		},
+		ResourceSchemas: map[string]*tfprotov6.Schema{
+			"test_resource": {
+				Block: &tfprotov6.SchemaBlock{
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							TypeName: "timeouts",
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+							Block: &tfprotov6.SchemaBlock{
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:     "create",
+										Type:     tftypes.String,
+										Optional: true,
+									},
+									{
+										Name:     "delete",
+										Type:     tftypes.String,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s *testProviderServer) ApplyResourceChange(ctx context.Context, _ *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	return &tfprotov6.ApplyResourceChangeResponse{
+		Diagnostics: s.record(ctx, "apply"),
+	}, nil
+}
+
+func (s *testProviderServer) ReadResource(ctx context.Context, _ *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	return &tfprotov6.ReadResourceResponse{
+		Diagnostics: s.record(ctx, "read"),
+	}, nil
+}
+
+func (s *testProviderServer) ImportResourceState(ctx context.Context, _ *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	return &tfprotov6.ImportResourceStateResponse{
+		Diagnostics: s.record(ctx, "import"),
+	}, nil
+}
+
+// testListProviderServer additionally implements the list resource RPCs.
+type testListProviderServer struct {
+	*testProviderServer
+}
+
+func (s *testListProviderServer) ValidateListResourceConfig(_ context.Context, _ *tfprotov6.ValidateListResourceConfigRequest) (*tfprotov6.ValidateListResourceConfigResponse, error) {
+	return &tfprotov6.ValidateListResourceConfigResponse{}, nil
+}
+
+func (s *testListProviderServer) ListResource(ctx context.Context, _ *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	diags := s.record(ctx, "list")
+
+	return &tfprotov6.ListResourceServerStream{
+		Results: func(yield func(tfprotov6.ListResourceResult) bool) {
+			yield(tfprotov6.ListResourceResult{Diagnostics: diags})
+		},
+	}, nil
+}
+
+func testDynamicValue(t *testing.T, create, deleteTimeout interface{}, null bool) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	value := tftypes.NewValue(testResourceType, nil)
+
+	if !null {
+		value = tftypes.NewValue(testResourceType, map[string]tftypes.Value{
+			"timeouts": tftypes.NewValue(testTimeoutsType, map[string]tftypes.Value{
+				"create": tftypes.NewValue(tftypes.String, create),
+				"delete": tftypes.NewValue(tftypes.String, deleteTimeout),
+			}),
+		})
+	}
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(testResourceType, value)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return &dynamicValue
+}
+
+func TestNewProviderServerApplyResourceChange(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		priorState       *tfprotov6.DynamicValue
+		plannedState     *tfprotov6.DynamicValue
+		expectedDeadline time.Duration
+	}
+	tests := map[string]testCase{
+		"create-configured": {
+			priorState:       testDynamicValue(t, nil, nil, true),
+			plannedState:     testDynamicValue(t, "1h", nil, false),
+			expectedDeadline: time.Hour,
+		},
+		"create-default": {
+			priorState:       testDynamicValue(t, nil, nil, true),
+			plannedState:     testDynamicValue(t, nil, nil, false),
+			expectedDeadline: 20 * time.Minute,
+		},
+		"create-relative": {
+			priorState:       testDynamicValue(t, nil, nil, true),
+			plannedState:     testDynamicValue(t, "2x", nil, false),
+			expectedDeadline: 40 * time.Minute,
+		},
+		"update-no-default": {
+			priorState:   testDynamicValue(t, "1h", nil, false),
+			plannedState: testDynamicValue(t, "1h", nil, false),
+		},
+		"delete": {
+			priorState:       testDynamicValue(t, nil, "45m", false),
+			plannedState:     testDynamicValue(t, nil, nil, true),
+			expectedDeadline: 45 * time.Minute,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			providerServer := &testProviderServer{
+				deadlines: map[string]time.Duration{},
+			}
+
+			s := tf6timeouts.NewProviderServer(providerServer, tf6timeouts.Opts{
+				Resources: map[string]tf6timeouts.Defaults{
+					"test_resource": {
+						"create": 20 * time.Minute,
+					},
+				},
+			})
+
+			resp, err := s.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+				TypeName:     "test_resource",
+				PriorState:   test.priorState,
+				PlannedState: test.plannedState,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(resp.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if diff := cmp.Diff(providerServer.deadlines, map[string]time.Duration{"apply": test.expectedDeadline}); diff != "" {
+				t.Errorf("unexpected deadlines difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewProviderServerReadResource(t *testing.T) {
+	t.Parallel()
+
+	providerServer := &testProviderServer{
+		deadlines: map[string]time.Duration{},
+	}
+
+	s := tf6timeouts.NewProviderServer(providerServer, tf6timeouts.Opts{
+		Resources: map[string]tf6timeouts.Defaults{
+			"test_resource": {
+				"read": 5 * time.Minute,
+			},
+		},
+	})
+
+	resp, err := s.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     "test_resource",
+		CurrentState: testDynamicValue(t, "1h", nil, false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(providerServer.deadlines, map[string]time.Duration{"read": 5 * time.Minute}); diff != "" {
+		t.Errorf("unexpected deadlines difference: %s", diff)
+	}
+}
+
+func TestNewProviderServerImportResourceState(t *testing.T) {
+	t.Parallel()
+
+	providerServer := &testProviderServer{
+		deadlines: map[string]time.Duration{},
+	}
+
+	s := tf6timeouts.NewProviderServer(providerServer, tf6timeouts.Opts{
+		Resources: map[string]tf6timeouts.Defaults{
+			"test_resource": {
+				"import": 10 * time.Minute,
+			},
+		},
+	})
+
+	if _, err := s.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+		TypeName: "test_resource",
+		ID:       "example",
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(providerServer.deadlines, map[string]time.Duration{"import": 10 * time.Minute}); diff != "" {
+		t.Errorf("unexpected deadlines difference: %s", diff)
+	}
+}
+
+func TestNewProviderServerDeadlineExceeded(t *testing.T) {
+	t.Parallel()
+
+	providerServer := &testProviderServer{
+		deadlines: map[string]time.Duration{},
+		wait:      true,
+	}
+
+	s := tf6timeouts.NewProviderServer(providerServer, tf6timeouts.Opts{})
+
+	resp, err := s.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "test_resource",
+		PriorState:   testDynamicValue(t, nil, nil, true),
+		PlannedState: testDynamicValue(t, "1ns", nil, false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(resp.Diagnostics), 2; got != expected {
+		t.Fatalf("expected %d diagnostics, got %d: %v", expected, got, resp.Diagnostics)
+	}
+
+	got := resp.Diagnostics[1]
+
+	if diff := cmp.Diff(got.Summary, "Operation Timed Out"); diff != "" {
+		t.Errorf("unexpected summary difference: %s", diff)
+	}
+
+	if expected := tftypes.NewAttributePath().WithAttributeName("timeouts").WithAttributeName("create"); !got.Attribute.Equal(expected) {
+		t.Errorf("expected attribute %s, got %s", expected, got.Attribute)
+	}
+
+	// The snippet uses the block syntax of the schema.
+	if expected := "for example:\n\n  timeouts {\n    create = \"2ns\"\n  }"; !strings.HasSuffix(got.Detail, expected) {
+		t.Errorf("expected detail ending with %q, got %q", expected, got.Detail)
+	}
+}
+
+func TestNewProviderServerInvalidTimeout(t *testing.T) {
+	t.Parallel()
+
+	providerServer := &testProviderServer{
+		deadlines: map[string]time.Duration{},
+	}
+
+	s := tf6timeouts.NewProviderServer(providerServer, tf6timeouts.Opts{})
+
+	resp, err := s.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "test_resource",
+		PriorState:   testDynamicValue(t, nil, nil, true),
+		PlannedState: testDynamicValue(t, "10y", nil, false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Timeout Cannot Be Parsed",
+			Detail:   `timeout for "create" cannot be parsed, time: unknown unit "y" in duration "10y"`,
+		},
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if len(providerServer.deadlines) > 0 {
+		t.Errorf("expected request not to be passed to the provider server, got %v", providerServer.deadlines)
+	}
+}
+
+func TestNewProviderServerModuleDefaults(t *testing.T) {
+	t.Parallel()
+
+	providerServer := &testProviderServer{
+		deadlines: map[string]time.Duration{},
+	}
+
+	s := tf6timeouts.NewProviderServer(providerServer, tf6timeouts.Opts{
+		Resources: map[string]tf6timeouts.Defaults{
+			"test_resource": {
+				"create": 10 * time.Minute,
+			},
+		},
+	})
+
+	providerMeta, err := tfprotov6.NewDynamicValue(testProviderMetaType, tftypes.NewValue(testProviderMetaType, map[string]tftypes.Value{
+		"default_timeouts": tftypes.NewValue(testTimeoutsType, map[string]tftypes.Value{
+			"create": tftypes.NewValue(tftypes.String, "30m"),
+			"delete": tftypes.NewValue(tftypes.String, nil),
+		}),
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := s.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "test_resource",
+		PriorState:   testDynamicValue(t, nil, nil, true),
+		PlannedState: testDynamicValue(t, nil, nil, false),
+		ProviderMeta: &providerMeta,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(providerServer.deadlines, map[string]time.Duration{"apply": 30 * time.Minute}); diff != "" {
+		t.Errorf("unexpected deadlines difference: %s", diff)
+	}
+}
+
+func TestNewProviderServerSchemaRetry(t *testing.T) {
+	t.Parallel()
+
+	providerServer := &testProviderServer{
+		deadlines:      map[string]time.Duration{},
+		schemaFailures: 1,
+	}
+
+	s := tf6timeouts.NewProviderServer(providerServer, tf6timeouts.Opts{})
+
+	req := &tfprotov6.ReadResourceRequest{
+		TypeName:     "test_resource",
+		CurrentState: testDynamicValue(t, "10m", nil, false),
+	}
+
+	resp, err := s.ReadResource(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(len(resp.Diagnostics), 1); diff != "" {
+		t.Fatalf("unexpected diagnostics count difference: %s", diff)
+	}
+
+	if len(providerServer.deadlines) > 0 {
+		t.Errorf("expected request not to be passed to the provider server, got %v", providerServer.deadlines)
+	}
+
+	// The failure is not cached, so the schemas are read again.
+	resp, err = s.ReadResource(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(providerServer.deadlines, map[string]time.Duration{"read": 0}); diff != "" {
+		t.Errorf("unexpected deadlines difference: %s", diff)
+	}
+}
+
+// The capability types of testProviderServer, in the order of the optional
+// interfaces in TestNewProviderServerOptionalRPCs.
+//
+//go:generate go run ../internal/cmd/gencombinations -output server_combinations_test.go -package tf6timeouts_test -function combineTestProviderServer -base testProviderServer -result tfprotov6.ProviderServer -import github.com/hashicorp/terraform-plugin-go/tfprotov6 testListResourceRPCs testActionRPCs testStateStoreRPCs
+type testListResourceRPCs struct {
+	*testProviderServer
+}
+
+func (testListResourceRPCs) ValidateListResourceConfig(_ context.Context, _ *tfprotov6.ValidateListResourceConfigRequest) (*tfprotov6.ValidateListResourceConfigResponse, error) {
+	return &tfprotov6.ValidateListResourceConfigResponse{}, nil
+}
+
+func (testListResourceRPCs) ListResource(_ context.Context, _ *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	return &tfprotov6.ListResourceServerStream{}, nil
+}
+
+type testActionRPCs struct {
+	*testProviderServer
+}
+
+func (testActionRPCs) ValidateActionConfig(_ context.Context, _ *tfprotov6.ValidateActionConfigRequest) (*tfprotov6.ValidateActionConfigResponse, error) {
+	return &tfprotov6.ValidateActionConfigResponse{}, nil
+}
+
+func (testActionRPCs) PlanAction(_ context.Context, _ *tfprotov6.PlanActionRequest) (*tfprotov6.PlanActionResponse, error) {
+	return &tfprotov6.PlanActionResponse{}, nil
+}
+
+func (testActionRPCs) InvokeAction(_ context.Context, _ *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionServerStream, error) {
+	return &tfprotov6.InvokeActionServerStream{}, nil
+}
+
+type testStateStoreRPCs struct {
+	*testProviderServer
+}
+
+func (testStateStoreRPCs) ValidateStateStoreConfig(_ context.Context, _ *tfprotov6.ValidateStateStoreConfigRequest) (*tfprotov6.ValidateStateStoreConfigResponse, error) {
+	return &tfprotov6.ValidateStateStoreConfigResponse{}, nil
+}
+
+func (testStateStoreRPCs) ConfigureStateStore(_ context.Context, _ *tfprotov6.ConfigureStateStoreRequest) (*tfprotov6.ConfigureStateStoreResponse, error) {
+	return &tfprotov6.ConfigureStateStoreResponse{}, nil
+}
+
+func (testStateStoreRPCs) ReadStateBytes(_ context.Context, _ *tfprotov6.ReadStateBytesRequest) (*tfprotov6.ReadStateBytesStream, error) {
+	return &tfprotov6.ReadStateBytesStream{}, nil
+}
+
+func (testStateStoreRPCs) WriteStateBytes(_ context.Context, _ *tfprotov6.WriteStateBytesStream) (*tfprotov6.WriteStateBytesResponse, error) {
+	return &tfprotov6.WriteStateBytesResponse{}, nil
+}
+
+func (testStateStoreRPCs) GetStates(_ context.Context, _ *tfprotov6.GetStatesRequest) (*tfprotov6.GetStatesResponse, error) {
+	return &tfprotov6.GetStatesResponse{}, nil
+}
+
+func (testStateStoreRPCs) DeleteState(_ context.Context, _ *tfprotov6.DeleteStateRequest) (*tfprotov6.DeleteStateResponse, error) {
+	return &tfprotov6.DeleteStateResponse{}, nil
+}
+
+func (testStateStoreRPCs) LockState(_ context.Context, _ *tfprotov6.LockStateRequest) (*tfprotov6.LockStateResponse, error) {
+	return &tfprotov6.LockStateResponse{}, nil
+}
+
+func (testStateStoreRPCs) UnlockState(_ context.Context, _ *tfprotov6.UnlockStateRequest) (*tfprotov6.UnlockStateResponse, error) {
+	return &tfprotov6.UnlockStateResponse{}, nil
+}
+
+//nolint:staticcheck // The list resource, action and state store RPCs are only available via the temporary interfaces.
+func TestNewProviderServerOptionalRPCs(t *testing.T) {
+	t.Parallel()
+
+	interfaces := []struct {
+		name        string
+		implemented func(tfprotov6.ProviderServer) bool
+	}{
+		{
+			name: "ListResource",
+			implemented: func(s tfprotov6.ProviderServer) bool {
+				_, ok := s.(tfprotov6.ProviderServerWithListResource)
+
+				return ok
+			},
+		},
+		{
+			name: "Actions",
+			implemented: func(s tfprotov6.ProviderServer) bool {
+				_, ok := s.(tfprotov6.ProviderServerWithActions)
+
+				return ok
+			},
+		},
+		{
+			name: "StateStores",
+			implemented: func(s tfprotov6.ProviderServer) bool {
+				_, ok := s.(tfprotov6.ProviderServerWithStateStores)
+
+				return ok
+			},
+		},
+	}
+
+	for wraps := 0; wraps < 1<<len(interfaces); wraps++ {
+		providerServer := combineTestProviderServer(&testProviderServer{}, wraps)
+		s := tf6timeouts.NewProviderServer(providerServer, tf6timeouts.Opts{})
+
+		for i, iface := range interfaces {
+			if expected := wraps&(1<<i) != 0; iface.implemented(providerServer) != expected {
+				t.Fatalf("combination %03b: expected test provider server %s to be %t", wraps, iface.name, expected)
+			}
+
+			if got, expected := iface.implemented(s), iface.implemented(providerServer); got != expected {
+				t.Errorf("combination %03b: expected provider server %s to be %t, got %t", wraps, iface.name, expected, got)
+			}
+		}
+	}
+}
+
+//nolint:staticcheck // ListResource RPCs are only available via the temporary interface.
+func TestNewProviderServerListResource(t *testing.T) {
+	t.Parallel()
+
+	providerServer := &testListProviderServer{
+		testProviderServer: &testProviderServer{
+			deadlines: map[string]time.Duration{},
+		},
+	}
+
+	s, ok := tf6timeouts.NewProviderServer(providerServer, tf6timeouts.Opts{
+		ListResources: map[string]tf6timeouts.Defaults{
+			"test_resource": {
+				"list": 10 * time.Minute,
+			},
+		},
+	}).(tfprotov6.ProviderServerWithListResource)
+	if !ok {
+		t.Fatal("expected provider server to implement tfprotov6.ProviderServerWithListResource")
+	}
+
+	stream, err := s.ListResource(context.Background(), &tfprotov6.ListResourceRequest{TypeName: "test_resource"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for result := range stream.Results {
+		if len(result.Diagnostics) > 0 {
+			t.Errorf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+	}
+
+	if diff := cmp.Diff(providerServer.deadlines, map[string]time.Duration{"list": 10 * time.Minute}); diff != "" {
+		t.Errorf("unexpected deadlines difference: %s", diff)
+	}
+}